@@ -0,0 +1,131 @@
+package space
+
+import (
+	"fmt"
+	"math"
+)
+
+// AABB is an axis-aligned bounding box
+type AABB struct {
+	// Min is the corner with the smallest X, Y and Z
+	Min Cartesian
+	// Max is the corner with the largest X, Y and Z
+	Max Cartesian
+}
+
+// NewAABB creates a new AABB which spans from a to b
+// a and b can be any opposite corners of the box
+func NewAABB(a, b Vector) AABB {
+	c := a.Cartesian()
+	d := b.Cartesian()
+	return AABB{
+		Min: Cartesian{
+			X: math.Min(c.X, d.X),
+			Y: math.Min(c.Y, d.Y),
+			Z: math.Min(c.Z, d.Z),
+		},
+		Max: Cartesian{
+			X: math.Max(c.X, d.X),
+			Y: math.Max(c.Y, d.Y),
+			Z: math.Max(c.Z, d.Z),
+		},
+	}
+}
+
+// NewAABBFromPoints creates the smallest AABB which contains every point
+// If there are no points, an AABB around the origin with no size is returned
+func NewAABBFromPoints(points ...Vector) AABB {
+	if len(points) == 0 {
+		return AABB{}
+	}
+	b := NewAABB(points[0], points[0])
+	for _, p := range points[1:] {
+		b = b.Expand(p)
+	}
+	return b
+}
+
+// Contains returns true if v is inside or on the surface of b
+func (b AABB) Contains(v Vector) bool {
+	c := v.Cartesian()
+	return c.X >= b.Min.X-MinErr && c.X <= b.Max.X+MinErr &&
+		c.Y >= b.Min.Y-MinErr && c.Y <= b.Max.Y+MinErr &&
+		c.Z >= b.Min.Z-MinErr && c.Z <= b.Max.Z+MinErr
+}
+
+// Intersects returns true if b and o overlap or touch
+func (b AABB) Intersects(o AABB) bool {
+	return b.Min.X <= o.Max.X+MinErr && b.Max.X >= o.Min.X-MinErr &&
+		b.Min.Y <= o.Max.Y+MinErr && b.Max.Y >= o.Min.Y-MinErr &&
+		b.Min.Z <= o.Max.Z+MinErr && b.Max.Z >= o.Min.Z-MinErr
+}
+
+// Union returns the smallest AABB which contains both b and o
+func (b AABB) Union(o AABB) AABB {
+	return AABB{
+		Min: Cartesian{
+			X: math.Min(b.Min.X, o.Min.X),
+			Y: math.Min(b.Min.Y, o.Min.Y),
+			Z: math.Min(b.Min.Z, o.Min.Z),
+		},
+		Max: Cartesian{
+			X: math.Max(b.Max.X, o.Max.X),
+			Y: math.Max(b.Max.Y, o.Max.Y),
+			Z: math.Max(b.Max.Z, o.Max.Z),
+		},
+	}
+}
+
+// Expand returns the smallest AABB which contains both b and v
+func (b AABB) Expand(v Vector) AABB {
+	c := v.Cartesian()
+	return b.Union(AABB{Min: c, Max: c})
+}
+
+// Center returns the point in the middle of b
+func (b AABB) Center() Cartesian {
+	return Cartesian{
+		X: (b.Min.X + b.Max.X) / 2,
+		Y: (b.Min.Y + b.Max.Y) / 2,
+		Z: (b.Min.Z + b.Max.Z) / 2,
+	}
+}
+
+// Size returns the length of b along each axis
+func (b AABB) Size() Cartesian {
+	return Cartesian{
+		X: b.Max.X - b.Min.X,
+		Y: b.Max.Y - b.Min.Y,
+		Z: b.Max.Z - b.Min.Z,
+	}
+}
+
+// Corners returns the eight corners of b
+func (b AABB) Corners() [8]Cartesian {
+	return [8]Cartesian{
+		{b.Min.X, b.Min.Y, b.Min.Z},
+		{b.Max.X, b.Min.Y, b.Min.Z},
+		{b.Min.X, b.Max.Y, b.Min.Z},
+		{b.Max.X, b.Max.Y, b.Min.Z},
+		{b.Min.X, b.Min.Y, b.Max.Z},
+		{b.Max.X, b.Min.Y, b.Max.Z},
+		{b.Min.X, b.Max.Y, b.Max.Z},
+		{b.Max.X, b.Max.Y, b.Max.Z},
+	}
+}
+
+// Transform returns the smallest AABB which contains b after it is transformed by m
+// Every corner is transformed, so projective matricies are supported
+func (b AABB) Transform(m Matrix) AABB {
+	corners := b.Corners()
+	first := m.Apply(corners[0])
+	t := AABB{Min: first, Max: first}
+	for _, c := range corners[1:] {
+		t = t.Expand(m.Apply(c))
+	}
+	return t
+}
+
+func (b AABB) String() string {
+	return fmt.Sprintf("{Min:%v, Max:%v}", b.Min, b.Max)
+}
@@ -0,0 +1,205 @@
+package space
+
+import (
+	"testing"
+)
+
+type AABBTest struct {
+	Initial   AABB
+	Operation func(AABB) AABB
+	Expected  AABB
+}
+
+func RunAABBTests(t *testing.T, cases []AABBTest) {
+	for i, c := range cases {
+		actual := c.Operation(c.Initial)
+		if !AABBsEqual(c.Expected, actual) {
+			t.Fatalf("Test %v failed. AABBs were not equal:\n\tExpected: %v,\n\tActual: %v", i, c.Expected, actual)
+		}
+	}
+}
+
+// AABBsEqual compares AABBs
+func AABBsEqual(a, b AABB) bool {
+	return CartesiansEqual(a.Min, b.Min) && CartesiansEqual(a.Max, b.Max)
+}
+
+var unitAABB = AABB{
+	Min: Cartesian{-1, -1, -1},
+	Max: Cartesian{1, 1, 1},
+}
+
+func TestNewAABB(t *testing.T) {
+	cases := []AABBTest{
+		{
+			Operation: func(AABB) AABB {
+				return NewAABB(Cartesian{1, -1, 1}, Cartesian{-1, 1, -1})
+			},
+			Expected: unitAABB,
+		},
+		{
+			Operation: func(AABB) AABB {
+				return NewAABB(OctantNXNYNZ3.Spherical, OctantXYZ3.Cartesian)
+			},
+			Expected: AABB{
+				Min: OctantNXNYNZ3.Cartesian,
+				Max: OctantXYZ3.Cartesian,
+			},
+		},
+		{
+			Operation: func(AABB) AABB {
+				return NewAABBFromPoints()
+			},
+			Expected: AABB{},
+		},
+		{
+			Operation: func(AABB) AABB {
+				return NewAABBFromPoints(AxisX.Cartesian, AxisYN3.Spherical, Cartesian{-2, 0, 5})
+			},
+			Expected: AABB{
+				Min: Cartesian{-2, -3, 0},
+				Max: Cartesian{1, 0, 5},
+			},
+		},
+	}
+	RunAABBTests(t, cases)
+}
+
+func TestAABBContains(t *testing.T) {
+	cases := []struct {
+		Vector   Vector
+		Expected bool
+	}{
+		{Origin.Cartesian, true},
+		{AxisX.Spherical, true},
+		{OctantNXYZ.Cartesian, true},
+		{Cartesian{1, 1, 1}, true},
+		{AxisZ3.Cartesian, false},
+		{OctantXYNZ3.Spherical, false},
+		{Cartesian{1.1, 0, 0}, false},
+	}
+	for i, c := range cases {
+		if actual := unitAABB.Contains(c.Vector); actual != c.Expected {
+			t.Fatalf("Contains %v failed:\n\tExpected: %v,\n\tActual: %v", i, c.Expected, actual)
+		}
+	}
+}
+
+func TestAABBIntersects(t *testing.T) {
+	cases := []struct {
+		AABB     AABB
+		Expected bool
+	}{
+		{unitAABB, true},
+		{NewAABB(Cartesian{0, 0, 0}, Cartesian{5, 5, 5}), true},
+		{NewAABB(Cartesian{-5, -5, -5}, Cartesian{5, 5, 5}), true},
+		{NewAABB(Cartesian{1, 1, 1}, Cartesian{2, 2, 2}), true},
+		{NewAABB(Cartesian{-3, -0.5, -0.5}, Cartesian{3, 0.5, 0.5}), true},
+		{NewAABB(Cartesian{1.5, 0, 0}, Cartesian{2, 2, 2}), false},
+		{NewAABB(Cartesian{0, 0, -3}, Cartesian{0, 0, -2}), false},
+	}
+	for i, c := range cases {
+		if actual := unitAABB.Intersects(c.AABB); actual != c.Expected {
+			t.Fatalf("Intersects %v failed:\n\tExpected: %v,\n\tActual: %v", i, c.Expected, actual)
+		}
+		if actual := c.AABB.Intersects(unitAABB); actual != c.Expected {
+			t.Fatalf("Intersects %v failed when reversed:\n\tExpected: %v,\n\tActual: %v", i, c.Expected, actual)
+		}
+	}
+}
+
+func TestAABBUnion(t *testing.T) {
+	cases := []AABBTest{
+		{
+			Initial: unitAABB,
+			Operation: func(b AABB) AABB {
+				return b.Union(NewAABB(Cartesian{0, 0, 0}, Cartesian{2, 3, 5}))
+			},
+			Expected: AABB{
+				Min: Cartesian{-1, -1, -1},
+				Max: Cartesian{2, 3, 5},
+			},
+		},
+		{
+			Initial: unitAABB,
+			Operation: func(b AABB) AABB {
+				return b.Expand(AxisZN3.Spherical)
+			},
+			Expected: AABB{
+				Min: Cartesian{-1, -1, -3},
+				Max: Cartesian{1, 1, 1},
+			},
+		},
+		{
+			Initial: unitAABB,
+			Operation: func(b AABB) AABB {
+				return b.Expand(Origin.Cartesian)
+			},
+			Expected: unitAABB,
+		},
+	}
+	RunAABBTests(t, cases)
+}
+
+func TestAABBCenterSize(t *testing.T) {
+	cases := []struct {
+		AABB   AABB
+		Center Cartesian
+		Size   Cartesian
+	}{
+		{
+			AABB:   unitAABB,
+			Center: Cartesian{0, 0, 0},
+			Size:   Cartesian{2, 2, 2},
+		},
+		{
+			AABB:   NewAABB(Cartesian{1, 2, 3}, Cartesian{3, 6, 9}),
+			Center: Cartesian{2, 4, 6},
+			Size:   Cartesian{2, 4, 6},
+		},
+	}
+	for i, c := range cases {
+		if actual := c.AABB.Center(); !CartesiansEqual(c.Center, actual) {
+			t.Fatalf("Center %v failed:\n\tExpected: %v,\n\tActual: %v", i, c.Center, actual)
+		}
+		if actual := c.AABB.Size(); !CartesiansEqual(c.Size, actual) {
+			t.Fatalf("Size %v failed:\n\tExpected: %v,\n\tActual: %v", i, c.Size, actual)
+		}
+	}
+}
+
+func TestAABBTransform(t *testing.T) {
+	cases := []AABBTest{
+		{
+			Initial: unitAABB,
+			Operation: func(b AABB) AABB {
+				return b.Transform(Cartesian{2, 3, 5}.TranslationMatrix())
+			},
+			Expected: AABB{
+				Min: Cartesian{1, 2, 4},
+				Max: Cartesian{3, 4, 6},
+			},
+		},
+		{
+			Initial: NewAABB(Cartesian{0, 0, 0}, Cartesian{2, 1, 1}),
+			Operation: func(b AABB) AABB {
+				return b.Transform(NewRotationMatrixZ(rad(1, 2)))
+			},
+			Expected: AABB{
+				Min: Cartesian{-1, 0, 0},
+				Max: Cartesian{0, 2, 1},
+			},
+		},
+		{
+			Initial: unitAABB,
+			Operation: func(b AABB) AABB {
+				return b.Transform(NewRotationMatrixZ(rad(1, 4)))
+			},
+			Expected: AABB{
+				Min: Cartesian{-2 * sqrt2o2, -2 * sqrt2o2, -1},
+				Max: Cartesian{2 * sqrt2o2, 2 * sqrt2o2, 1},
+			},
+		},
+	}
+	RunAABBTests(t, cases)
+}
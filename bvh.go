@@ -0,0 +1,384 @@
+package space
+
+import (
+	"math"
+)
+
+const (
+	// bvhLeafSize is the most triangles a node holds without considering a split
+	bvhLeafSize = 4
+	// bvhBins is how many places along each axis a node considers splitting at
+	bvhBins = 12
+	// bvhTraversalCost is the cost of visiting a node, relative to testing a triangle
+	bvhTraversalCost = 1
+)
+
+// BVH is a bounding volume hierarchy, which finds the triangles of a mesh without checking every one
+// The tree is built with the surface area heuristic: each node is split where the chance of a ray
+// meeting each side, times the triangles on that side, is smallest.
+// BVHs are not safe for concurrent use while being refit.
+type BVH struct {
+	root *bvhNode
+	// original are the triangles which the BVH was built from
+	original []Triangle
+	// triangles are the original triangles, as transformed by the last Refit
+	triangles []Triangle
+}
+
+type bvhNode struct {
+	bounds      AABB
+	left, right *bvhNode
+	// indices are the triangles held by a leaf
+	indices []int
+}
+
+// TriangleHit is where a Ray meets a triangle in a BVH
+type TriangleHit struct {
+	Hit
+	// Index is the position of the triangle in the list which the BVH was built from
+	Index int
+}
+
+// NewBVH creates a BVH holding triangles
+func NewBVH(triangles ...Triangle) *BVH {
+	t := &BVH{
+		original:  make([]Triangle, len(triangles)),
+		triangles: make([]Triangle, len(triangles)),
+	}
+	copy(t.original, triangles)
+	copy(t.triangles, triangles)
+	if len(triangles) == 0 {
+		return t
+	}
+
+	indices := make([]int, len(triangles))
+	bounds := make([]AABB, len(triangles))
+	centroids := make([]Cartesian, len(triangles))
+	for i, tri := range triangles {
+		indices[i] = i
+		bounds[i] = tri.Bounds()
+		centroids[i] = tri.Centroid()
+	}
+	t.root = buildBVHNode(indices, bounds, centroids)
+	return t
+}
+
+// buildBVHNode builds a tree holding the triangles at indices
+// indices is reordered, and shared with the leaves of the tree.
+func buildBVHNode(indices []int, bounds []AABB, centroids []Cartesian) *bvhNode {
+	n := &bvhNode{bounds: bounds[indices[0]]}
+	for _, i := range indices[1:] {
+		n.bounds = n.bounds.Union(bounds[i])
+	}
+	if len(indices) <= bvhLeafSize {
+		n.indices = indices
+		return n
+	}
+	split, ok := bestBVHSplit(n.bounds, indices, bounds, centroids)
+	if !ok {
+		n.indices = indices
+		return n
+	}
+
+	// Move the triangles on the left of the split to the front
+	middle := 0
+	for i, index := range indices {
+		if split.left(centroids[index]) {
+			indices[i], indices[middle] = indices[middle], indices[i]
+			middle++
+		}
+	}
+	n.left = buildBVHNode(indices[:middle], bounds, centroids)
+	n.right = buildBVHNode(indices[middle:], bounds, centroids)
+	return n
+}
+
+// bvhSplit divides the triangles of a node by which bin along an axis their centroids fall in
+type bvhSplit struct {
+	axis int
+	// bin is the first bin on the right
+	bin         int
+	low, extent float64
+}
+
+// binFor returns the bin along the axis of s which value falls in
+func (s bvhSplit) binFor(value float64) int {
+	bin := int(bvhBins * (value - s.low) / s.extent)
+	if bin >= bvhBins {
+		return bvhBins - 1
+	}
+	if bin < 0 {
+		return 0
+	}
+	return bin
+}
+
+// left returns true if a triangle with centroid c belongs on the left of s
+func (s bvhSplit) left(c Cartesian) bool {
+	return s.binFor(axisValue(c, s.axis)) < s.bin
+}
+
+// bestBVHSplit finds the split of the triangles at indices with the lowest cost
+// If no split costs less than testing every triangle, ok is false.
+func bestBVHSplit(parent AABB, indices []int, bounds []AABB, centroids []Cartesian) (best bvhSplit, ok bool) {
+	centroidBounds := AABB{Min: centroids[indices[0]], Max: centroids[indices[0]]}
+	for _, i := range indices[1:] {
+		centroidBounds = centroidBounds.Expand(centroids[i])
+	}
+
+	bestCost := float64(len(indices))
+	parentArea := surfaceArea(parent)
+	for axis := 0; axis < 3; axis++ {
+		low := axisValue(centroidBounds.Min, axis)
+		extent := axisValue(centroidBounds.Max, axis) - low
+		if extent <= MinErr {
+			continue
+		}
+		s := bvhSplit{axis: axis, low: low, extent: extent}
+
+		var counts [bvhBins]int
+		var boxes [bvhBins]AABB
+		for _, i := range indices {
+			bin := s.binFor(axisValue(centroids[i], axis))
+			if counts[bin] == 0 {
+				boxes[bin] = bounds[i]
+			} else {
+				boxes[bin] = boxes[bin].Union(bounds[i])
+			}
+			counts[bin]++
+		}
+
+		// rightCosts[b] is the area times the triangle count of bins b and above
+		var rightCosts [bvhBins]float64
+		var rightCounts [bvhBins]int
+		var right AABB
+		count := 0
+		for bin := bvhBins - 1; bin > 0; bin-- {
+			if counts[bin] > 0 {
+				if count == 0 {
+					right = boxes[bin]
+				} else {
+					right = right.Union(boxes[bin])
+				}
+				count += counts[bin]
+			}
+			rightCounts[bin] = count
+			rightCosts[bin] = surfaceArea(right) * float64(count)
+		}
+
+		var left AABB
+		count = 0
+		for bin := 1; bin < bvhBins; bin++ {
+			if counts[bin-1] > 0 {
+				if count == 0 {
+					left = boxes[bin-1]
+				} else {
+					left = left.Union(boxes[bin-1])
+				}
+				count += counts[bin-1]
+			}
+			if count == 0 || rightCounts[bin] == 0 {
+				continue
+			}
+			cost := surfaceArea(left)*float64(count) + rightCosts[bin]
+			if parentArea > 0 {
+				cost = bvhTraversalCost + cost/parentArea
+			}
+			if cost < bestCost {
+				bestCost = cost
+				s.bin = bin
+				best, ok = s, true
+			}
+		}
+	}
+	return best, ok
+}
+
+// surfaceArea returns the area of the surface of b
+func surfaceArea(b AABB) float64 {
+	s := b.Size()
+	return 2 * ((s.X * s.Y) + (s.Y * s.Z) + (s.Z * s.X))
+}
+
+// Len returns the number of triangles in t
+func (t *BVH) Len() int {
+	return len(t.triangles)
+}
+
+// Triangles returns the triangles of t, as transformed by the last Refit
+func (t *BVH) Triangles() []Triangle {
+	triangles := make([]Triangle, len(t.triangles))
+	copy(triangles, t.triangles)
+	return triangles
+}
+
+// Bounds returns the smallest AABB which contains every triangle in t
+// If t is empty, an AABB around the origin with no size is returned
+func (t *BVH) Bounds() AABB {
+	if t.root == nil {
+		return AABB{}
+	}
+	return t.root.bounds
+}
+
+// Refit moves the triangles of t to where m takes the triangles t was built from, and updates the tree to match
+// m is not combined with the matrix of earlier Refits, so an Object's matrix can be passed each time it moves.
+// The shape of the tree is kept, so queries slow down if triangles move far relative to each other.
+func (t *BVH) Refit(m Matrix) {
+	for i, tri := range t.original {
+		t.triangles[i] = tri.Transform(m)
+	}
+	var refit func(n *bvhNode)
+	refit = func(n *bvhNode) {
+		if n.left == nil {
+			n.bounds = t.triangles[n.indices[0]].Bounds()
+			for _, i := range n.indices[1:] {
+				n.bounds = n.bounds.Union(t.triangles[i].Bounds())
+			}
+			return
+		}
+		refit(n.left)
+		refit(n.right)
+		n.bounds = n.left.bounds.Union(n.right.bounds)
+	}
+	if t.root != nil {
+		refit(t.root)
+	}
+}
+
+// IntersectRay finds where r first meets a triangle in t
+// The normal of the Hit faces the origin of r.
+func (t *BVH) IntersectRay(r Ray) (hit TriangleHit, ok bool) {
+	if t.root == nil {
+		return TriangleHit{}, false
+	}
+	inverse := inverseDirection(r)
+	limit := math.Inf(1)
+	var search func(n *bvhNode)
+	search = func(n *bvhNode) {
+		if n.left == nil {
+			for _, i := range n.indices {
+				if h, found := t.triangles[i].IntersectRay(r); found && h.Distance < limit {
+					hit, ok = TriangleHit{Hit: h, Index: i}, true
+					limit = h.Distance
+				}
+			}
+			return
+		}
+		// Searching the closer child first lets hits in it rule out the other
+		first, second := n.left, n.right
+		firstEnter, firstOK := boxEnterDistance(r.Origin, inverse, first.bounds, limit)
+		secondEnter, secondOK := boxEnterDistance(r.Origin, inverse, second.bounds, limit)
+		if secondOK && (!firstOK || secondEnter < firstEnter) {
+			first, second = second, first
+			firstEnter, secondEnter = secondEnter, firstEnter
+			firstOK, secondOK = secondOK, firstOK
+		}
+		if firstOK {
+			search(first)
+		}
+		if secondOK && secondEnter <= limit {
+			search(second)
+		}
+	}
+	if _, found := boxEnterDistance(r.Origin, inverse, t.root.bounds, limit); found {
+		search(t.root)
+	}
+	return hit, ok
+}
+
+// IntersectsRay returns true if r meets any triangle in t within distance of its origin
+// It stops at the first triangle found, so it is faster than IntersectRay for checking occlusion.
+func (t *BVH) IntersectsRay(r Ray, distance float64) bool {
+	if t.root == nil {
+		return false
+	}
+	inverse := inverseDirection(r)
+	var search func(n *bvhNode) bool
+	search = func(n *bvhNode) bool {
+		if _, ok := boxEnterDistance(r.Origin, inverse, n.bounds, distance); !ok {
+			return false
+		}
+		if n.left == nil {
+			for _, i := range n.indices {
+				if h, ok := t.triangles[i].IntersectRay(r); ok && h.Distance <= distance {
+					return true
+				}
+			}
+			return false
+		}
+		return search(n.left) || search(n.right)
+	}
+	return search(t.root)
+}
+
+// ClosestPoint returns the point on the triangles of t which is closest to v, and the index of its triangle
+// If t is empty, ok is false.
+func (t *BVH) ClosestPoint(v Vector) (point Cartesian, index int, ok bool) {
+	if t.root == nil {
+		return Cartesian{}, 0, false
+	}
+	c := v.Cartesian()
+	bestSquared := math.Inf(1)
+	var search func(n *bvhNode)
+	search = func(n *bvhNode) {
+		if n.left == nil {
+			for _, i := range n.indices {
+				p := t.triangles[i].ClosestPoint(c)
+				if d := distanceSquared(p, c); d < bestSquared {
+					point, index, ok = p, i, true
+					bestSquared = d
+				}
+			}
+			return
+		}
+		first, second := n.left, n.right
+		firstDistance := distanceSquaredToAABB(c, first.bounds)
+		secondDistance := distanceSquaredToAABB(c, second.bounds)
+		if secondDistance < firstDistance {
+			first, second = second, first
+			firstDistance, secondDistance = secondDistance, firstDistance
+		}
+		if firstDistance < bestSquared {
+			search(first)
+		}
+		if secondDistance < bestSquared {
+			search(second)
+		}
+	}
+	search(t.root)
+	return point, index, ok
+}
+
+// inverseDirection returns one over each axis of the direction of r
+// Axes along which r doesn't move are infinite.
+func inverseDirection(r Ray) Cartesian {
+	d := r.direction()
+	return Cartesian{1 / d.X, 1 / d.Y, 1 / d.Z}
+}
+
+// boxEnterDistance returns how far along a ray from origin, with the given inverse direction, it enters b
+// If origin is inside b, the distance is 0. If the ray misses b, or enters it beyond limit, ok is false.
+func boxEnterDistance(origin, inverse Cartesian, b AABB, limit float64) (distance float64, ok bool) {
+	enter, exit := 0.0, limit
+	for axis := 0; axis < 3; axis++ {
+		o, inv := axisValue(origin, axis), axisValue(inverse, axis)
+		low, high := axisValue(b.Min, axis), axisValue(b.Max, axis)
+		if math.IsInf(inv, 0) {
+			if o < low-MinErr || o > high+MinErr {
+				return 0, false
+			}
+			continue
+		}
+		t1, t2 := (low-o)*inv, (high-o)*inv
+		if t1 > t2 {
+			t1, t2 = t2, t1
+		}
+		enter = math.Max(enter, t1)
+		exit = math.Min(exit, t2)
+		if enter > exit+MinErr {
+			return 0, false
+		}
+	}
+	return enter, true
+}
@@ -0,0 +1,252 @@
+package space
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// randomTriangles returns n triangles up to 5 units across, spread through a 100 unit cube
+func randomTriangles(n int, seed int64) []Triangle {
+	r := rand.New(rand.NewSource(seed))
+	corner := func(center Cartesian) Cartesian {
+		return Cartesian{
+			X: center.X + r.Float64()*5 - 2.5,
+			Y: center.Y + r.Float64()*5 - 2.5,
+			Z: center.Z + r.Float64()*5 - 2.5,
+		}
+	}
+	triangles := make([]Triangle, n)
+	for i, center := range randomCartesians(r, n, 0, 100) {
+		triangles[i] = NewTriangle(corner(center), corner(center), corner(center))
+	}
+	return triangles
+}
+
+// randomRays returns n rays which start around a 100 unit cube and point in any direction
+func randomRays(n int, seed int64) []Ray {
+	r := rand.New(rand.NewSource(seed))
+	rays := make([]Ray, n)
+	for i := range rays {
+		origin := Cartesian{r.Float64()*140 - 20, r.Float64()*140 - 20, r.Float64()*140 - 20}
+		// Aim most rays through the cube, so that they have something to hit
+		target := Cartesian{r.Float64() * 100, r.Float64() * 100, r.Float64() * 100}
+		rays[i] = NewRay(origin, target.Subtract(origin).Spherical())
+	}
+	// Some rays point straight along an axis
+	rays = append(rays,
+		NewRay(Cartesian{50, 50, -10}, AxisZ.Spherical),
+		NewRay(Cartesian{-10, 30, 70}, AxisX.Spherical),
+		NewRay(Cartesian{20, 120, 40}, AxisYN.Spherical),
+	)
+	return rays
+}
+
+// linearIntersectRay finds where r first meets triangles by checking every triangle
+func linearIntersectRay(triangles []Triangle, r Ray) (TriangleHit, bool) {
+	best, ok := TriangleHit{}, false
+	for i, tri := range triangles {
+		if h, found := tri.IntersectRay(r); found && (!ok || h.Distance < best.Distance) {
+			best, ok = TriangleHit{Hit: h, Index: i}, true
+		}
+	}
+	return best, ok
+}
+
+// linearClosestPoint finds the closest point on triangles to v by checking every triangle
+func linearClosestPoint(triangles []Triangle, v Cartesian) Cartesian {
+	best := triangles[0].ClosestPoint(v)
+	for _, tri := range triangles[1:] {
+		if p := tri.ClosestPoint(v); distanceSquared(p, v) < distanceSquared(best, v) {
+			best = p
+		}
+	}
+	return best
+}
+
+// checkBVH fails if a node of t doesn't contain what is under it
+func checkBVH(t *testing.T, tree *BVH) {
+	seen := map[int]bool{}
+	var walk func(n *bvhNode) AABB
+	walk = func(n *bvhNode) AABB {
+		var b AABB
+		if n.left == nil {
+			b = tree.triangles[n.indices[0]].Bounds()
+			for _, i := range n.indices {
+				seen[i] = true
+				b = b.Union(tree.triangles[i].Bounds())
+			}
+		} else {
+			b = walk(n.left).Union(walk(n.right))
+		}
+		if !AABBsEqual(b, n.bounds) {
+			t.Fatalf("BVH failed. Node bounds were not equal:\n\tExpected: %v,\n\tActual: %v", b, n.bounds)
+		}
+		return b
+	}
+	walk(tree.root)
+	if len(seen) != tree.Len() {
+		t.Fatalf("BVH failed. Expected %v triangles, found %v", tree.Len(), len(seen))
+	}
+}
+
+// checkBVHQueries compares the queries of tree against checking every triangle
+func checkBVHQueries(t *testing.T, tree *BVH, triangles []Triangle, rays []Ray) {
+	for i, r := range rays {
+		expected, expectedOK := linearIntersectRay(triangles, r)
+		actual, ok := tree.IntersectRay(r)
+		if ok != expectedOK {
+			t.Fatalf("IntersectRay %v failed:\n\tExpected ok: %v,\n\tActual ok: %v", i, expectedOK, ok)
+		}
+		// Triangles which share the hit point may be found in either order
+		if ok && (!near(expected.Distance, actual.Distance) || !HitsEqual(mustHit(t, triangles[actual.Index], r), actual.Hit)) {
+			t.Fatalf("IntersectRay %v failed. Hits were not equal:\n\tExpected: %v,\n\tActual: %v", i, expected, actual)
+		}
+
+		for _, distance := range []float64{10, 50, math.Inf(1)} {
+			expected := expectedOK && expected.Distance <= distance
+			if actual := tree.IntersectsRay(r, distance); expected != actual {
+				t.Fatalf("IntersectsRay %v failed within %v:\n\tExpected: %v,\n\tActual: %v", i, distance, expected, actual)
+			}
+		}
+
+		v := r.Origin
+		expectedPoint := linearClosestPoint(triangles, v)
+		point, index, ok := tree.ClosestPoint(v)
+		if !ok || !near(distanceSquared(expectedPoint, v), distanceSquared(point, v)) ||
+			!CartesiansEqual(triangles[index].ClosestPoint(v), point) {
+			t.Fatalf("ClosestPoint %v failed:\n\tExpected: %v,\n\tActual: %v", i, expectedPoint, point)
+		}
+	}
+}
+
+// mustHit returns where r meets tri, failing if it doesn't
+func mustHit(t *testing.T, tri Triangle, r Ray) Hit {
+	h, ok := tri.IntersectRay(r)
+	if !ok {
+		t.Fatalf("Triangle %v was not hit by %v", tri, r)
+	}
+	return h
+}
+
+func TestNewBVH(t *testing.T) {
+	triangles := randomTriangles(2000, 1)
+	tree := NewBVH(triangles...)
+	if tree.Len() != len(triangles) {
+		t.Fatalf("NewBVH failed. Expected %v triangles, got %v", len(triangles), tree.Len())
+	}
+	if tree.root.left == nil {
+		t.Fatalf("NewBVH failed. Tree was not split")
+	}
+	checkBVH(t, tree)
+	checkBVHQueries(t, tree, triangles, randomRays(300, 2))
+
+	expected := triangles[0].Bounds()
+	for _, tri := range triangles {
+		expected = expected.Union(tri.Bounds())
+	}
+	if !AABBsEqual(expected, tree.Bounds()) {
+		t.Fatalf("Bounds failed:\n\tExpected: %v,\n\tActual: %v", expected, tree.Bounds())
+	}
+}
+
+func TestBVHGrid(t *testing.T) {
+	// A flat grid of triangles, where rays meet shared edges and corners
+	triangles := []Triangle{}
+	for x := 0; x < 20; x++ {
+		for y := 0; y < 20; y++ {
+			a := Cartesian{float64(x), float64(y), 0}
+			b := Cartesian{float64(x + 1), float64(y), 0}
+			c := Cartesian{float64(x), float64(y + 1), 0}
+			d := Cartesian{float64(x + 1), float64(y + 1), 0}
+			triangles = append(triangles, NewTriangle(a, b, d), NewTriangle(a, d, c))
+		}
+	}
+	tree := NewBVH(triangles...)
+	checkBVH(t, tree)
+	rays := []Ray{
+		NewRay(Cartesian{3, 4, 5}, AxisZN.Spherical),
+		NewRay(Cartesian{3.5, 4.25, 5}, AxisZN.Spherical),
+		NewRay(Cartesian{-5, -5, 5}, OctantXYNZ.Spherical),
+		NewRay(Cartesian{10, 10, -5}, AxisZ.Spherical),
+		NewRay(Cartesian{10, 10, 5}, AxisZ.Spherical),
+		NewRay(Cartesian{-1, 5, 0.5}, AxisX.Spherical),
+		NewRay(Cartesian{30, 5, 1}, AxisZN.Spherical),
+	}
+	checkBVHQueries(t, tree, triangles, rays)
+
+	hit, ok := tree.IntersectRay(rays[1])
+	expected := Hit{Distance: 5, Point: Cartesian{3.5, 4.25, 0}, Normal: Cartesian{0, 0, 1}}
+	if !ok || !HitsEqual(expected, hit.Hit) || hit.Index != 2*(3*20+4) {
+		t.Fatalf("IntersectRay failed:\n\tExpected: %v,\n\tActual: %v", expected, hit)
+	}
+}
+
+func TestBVHRefit(t *testing.T) {
+	triangles := randomTriangles(1000, 3)
+	tree := NewBVH(triangles...)
+	rays := randomRays(200, 4)
+	matricies := []Matrix{
+		NewTransformBuilder().RotateZ(rad(1, 3)).Translate(Cartesian{10, -20, 5}).Matrix(),
+		NewTransformBuilder().Scale(0.5, 1.5, 1).RotateAxisAngle(OctantXYZ.Cartesian, rad(2, 3)).Matrix(),
+		NewIdentityMatrix(),
+	}
+	for _, m := range matricies {
+		tree.Refit(m)
+		moved := make([]Triangle, len(triangles))
+		for i, tri := range triangles {
+			moved[i] = tri.Transform(m)
+		}
+		checkBVH(t, tree)
+		checkBVHQueries(t, tree, moved, rays)
+	}
+}
+
+func TestBVHEmpty(t *testing.T) {
+	tree := NewBVH()
+	tree.Refit(NewScaleMatrix(2, 2, 2))
+	r := NewRay(Cartesian{}, AxisX.Spherical)
+	if _, ok := tree.IntersectRay(r); ok {
+		t.Fatalf("IntersectRay failed. Empty tree was hit")
+	}
+	if tree.IntersectsRay(r, math.Inf(1)) {
+		t.Fatalf("IntersectsRay failed. Empty tree was hit")
+	}
+	if _, _, ok := tree.ClosestPoint(Cartesian{}); ok {
+		t.Fatalf("ClosestPoint failed. Empty tree had a point")
+	}
+	if tree.Len() != 0 || !AABBsEqual(AABB{}, tree.Bounds()) {
+		t.Fatalf("NewBVH failed. Empty tree had triangles")
+	}
+}
+
+var benchmarkTriangleHit TriangleHit
+
+func BenchmarkBVHIntersectRay(b *testing.B) {
+	tree := NewBVH(randomTriangles(10000, 5)...)
+	rays := randomRays(100, 6)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchmarkTriangleHit, _ = tree.IntersectRay(rays[i%len(rays)])
+	}
+}
+
+func BenchmarkLinearIntersectRay(b *testing.B) {
+	triangles := randomTriangles(10000, 5)
+	rays := randomRays(100, 6)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchmarkTriangleHit, _ = linearIntersectRay(triangles, rays[i%len(rays)])
+	}
+}
+
+func BenchmarkNewBVH(b *testing.B) {
+	triangles := randomTriangles(10000, 7)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		NewBVH(triangles...)
+	}
+}
@@ -0,0 +1,96 @@
+package space
+
+import (
+	"math"
+)
+
+// A Camera is an Object which views space through a perspective projection
+// The camera looks along its orientation, with its rotation pointing up the screen.
+type Camera struct {
+	Object
+	// FieldOfView is the vertical angle which the camera can see (radians)
+	FieldOfView float64
+	// AspectRatio is the width of the screen divided by its height
+	AspectRatio float64
+	// Near is the distance from the camera to the closest visible plane
+	Near float64
+	// Far is the distance from the camera to the furthest visible plane
+	Far float64
+}
+
+// NewCamera creates a camera
+func NewCamera(location Cartesian, orientation, rotation Spherical, fieldOfView, aspectRatio, near, far float64) *Camera {
+	return &Camera{
+		Object:      *NewObject(location, orientation, rotation),
+		FieldOfView: fieldOfView,
+		AspectRatio: aspectRatio,
+		Near:        near,
+		Far:         far,
+	}
+}
+
+// NewPerspectiveMatrix produces a matrix which will transform from view space into clip space
+// View space looks down -Z with Y up. After the homogeneous divide, the visible volume spans
+// [-1, 1] on each axis, with Z of -1 at near and 1 at far.
+func NewPerspectiveMatrix(fieldOfView, aspectRatio, near, far float64) Matrix {
+	f := 1 / math.Tan(fieldOfView/2)
+	return Matrix{
+		{f / aspectRatio, 0, 0, 0},
+		{0, f, 0, 0},
+		{0, 0, (far + near) / (near - far), (2 * far * near) / (near - far)},
+		{0, 0, -1, 0},
+	}
+}
+
+// NewOrthographicMatrix produces a matrix which will transform the box described by its
+// bounds in view space onto [-1, 1] on each axis
+// View space looks down -Z with Y up, so near and far are distances along -Z.
+func NewOrthographicMatrix(left, right, bottom, top, near, far float64) Matrix {
+	return Matrix{
+		{2 / (right - left), 0, 0, -(right + left) / (right - left)},
+		{0, 2 / (top - bottom), 0, -(top + bottom) / (top - bottom)},
+		{0, 0, -2 / (far - near), -(far + near) / (far - near)},
+		{0, 0, 0, 1},
+	}
+}
+
+// ViewMatrix produces a matrix which will transform from world space into view space
+// View space looks down -Z with Y up, so the camera's local Z (its orientation) becomes -Z
+// and its local Y (its rotation) stays Y.
+func (c Camera) ViewMatrix() Matrix {
+	return NewRotationMatrixY(math.Pi).Multiply(c.InverseMatrix())
+}
+
+// ProjectionMatrix produces a matrix which will transform from view space into clip space
+func (c Camera) ProjectionMatrix() Matrix {
+	return NewPerspectiveMatrix(c.FieldOfView, c.AspectRatio, c.Near, c.Far)
+}
+
+// ViewProjectionMatrix produces a matrix which will transform from world space into clip space
+func (c Camera) ViewProjectionMatrix() Matrix {
+	return c.ProjectionMatrix().Multiply(c.ViewMatrix())
+}
+
+// ProjectToScreen returns the screen coordinates of v
+// X and Y run from -1 to 1 across the screen (right and up), and Z is the depth,
+// running from -1 at the near plane to 1 at the far plane.
+// Points outside the screen or depth range are still projected.
+// Points behind the camera (or level with it) have no projection, and ok is false.
+func (c Camera) ProjectToScreen(v Vector) (screen Cartesian, ok bool) {
+	view := c.ViewMatrix().Apply(v.Cartesian())
+	if view.Z > -MinErr {
+		return Cartesian{}, false
+	}
+	return view.Transform(c.ProjectionMatrix()).Cartesian(), true
+}
+
+// Unproject returns the world location of the screen coordinates x and y at depth
+// The coordinates match ProjectToScreen. If the camera's projection cannot be inverted
+// (a zero field of view, aspect ratio or near plane), ok is false.
+func (c Camera) Unproject(x, y, depth float64) (world Cartesian, ok bool) {
+	inverse, ok := c.ViewProjectionMatrix().Inverse()
+	if !ok {
+		return Cartesian{}, false
+	}
+	return Cartesian{x, y, depth}.Transform(inverse).Cartesian(), true
+}
@@ -0,0 +1,197 @@
+package space
+
+import (
+	"testing"
+)
+
+// newTestCamera creates a camera at (1, 2, 3) looking along X with Z up
+// The screen's right is -Y, and a point 5 along X is at a depth of 0.5.
+func newTestCamera() *Camera {
+	return NewCamera(Cartesian{1, 2, 3}, AxisX.Spherical, AxisZ.Spherical, rad(1, 2), 2, 2, 10)
+}
+
+func TestNewPerspectiveMatrix(t *testing.T) {
+	m := NewPerspectiveMatrix(rad(1, 2), 2, 1, 9)
+	cases := []CartesianTest{
+		{
+			Initial: Cartesian{0, 0, -1},
+			Operation: func(v Cartesian) Cartesian {
+				return v.Transform(m).Cartesian()
+			},
+			Expected: Cartesian{0, 0, -1},
+		},
+		{
+			Initial: Cartesian{0, 0, -9},
+			Operation: func(v Cartesian) Cartesian {
+				return v.Transform(m).Cartesian()
+			},
+			Expected: Cartesian{0, 0, 1},
+		},
+		{
+			// The corners of the far plane
+			Initial: Cartesian{18, -9, -9},
+			Operation: func(v Cartesian) Cartesian {
+				return v.Transform(m).Cartesian()
+			},
+			Expected: Cartesian{1, -1, 1},
+		},
+		{
+			Initial: Cartesian{-2, 1, -1},
+			Operation: func(v Cartesian) Cartesian {
+				return v.Transform(m).Cartesian()
+			},
+			Expected: Cartesian{-1, 1, -1},
+		},
+	}
+	RunCartesianTests(t, cases)
+}
+
+func TestNewOrthographicMatrix(t *testing.T) {
+	m := NewOrthographicMatrix(-4, 2, 1, 3, 1, 5)
+	cases := []CartesianTest{
+		{
+			Initial: Cartesian{-4, 1, -1},
+			Operation: func(v Cartesian) Cartesian {
+				return v.Transform(m).Cartesian()
+			},
+			Expected: Cartesian{-1, -1, -1},
+		},
+		{
+			Initial: Cartesian{2, 3, -5},
+			Operation: func(v Cartesian) Cartesian {
+				return v.Transform(m).Cartesian()
+			},
+			Expected: Cartesian{1, 1, 1},
+		},
+		{
+			Initial: Cartesian{-1, 2, -3},
+			Operation: func(v Cartesian) Cartesian {
+				return v.Transform(m).Cartesian()
+			},
+			Expected: Cartesian{0, 0, 0},
+		},
+	}
+	RunCartesianTests(t, cases)
+}
+
+func TestCameraViewMatrix(t *testing.T) {
+	c := newTestCamera()
+	cases := []CartesianTest{
+		{
+			Initial: Cartesian{1, 2, 3},
+			Operation: func(v Cartesian) Cartesian {
+				return v.Transform(c.ViewMatrix()).Cartesian()
+			},
+			Expected: Cartesian{0, 0, 0},
+		},
+		{
+			// Forward is -Z
+			Initial: Cartesian{6, 2, 3},
+			Operation: func(v Cartesian) Cartesian {
+				return v.Transform(c.ViewMatrix()).Cartesian()
+			},
+			Expected: Cartesian{0, 0, -5},
+		},
+		{
+			// Up is Y
+			Initial: Cartesian{1, 2, 5},
+			Operation: func(v Cartesian) Cartesian {
+				return v.Transform(c.ViewMatrix()).Cartesian()
+			},
+			Expected: Cartesian{0, 2, 0},
+		},
+		{
+			// Right is X
+			Initial: Cartesian{1, 1, 3},
+			Operation: func(v Cartesian) Cartesian {
+				return v.Transform(c.ViewMatrix()).Cartesian()
+			},
+			Expected: Cartesian{1, 0, 0},
+		},
+	}
+	RunCartesianTests(t, cases)
+}
+
+func TestCameraProjectToScreen(t *testing.T) {
+	c := newTestCamera()
+	cases := []struct {
+		Vector   Vector
+		Expected Cartesian
+		OK       bool
+	}{
+		{
+			Vector:   Cartesian{6, 2, 3},
+			Expected: Cartesian{0, 0, 0.5},
+			OK:       true,
+		},
+		{
+			Vector:   Cartesian{3, 2, 3},
+			Expected: Cartesian{0, 0, -1},
+			OK:       true,
+		},
+		{
+			Vector:   Cartesian{11, 2, 3},
+			Expected: Cartesian{0, 0, 1},
+			OK:       true,
+		},
+		{
+			// The top right corner of the screen
+			Vector:   Cartesian{6, -8, 8},
+			Expected: Cartesian{1, 1, 0.5},
+			OK:       true,
+		},
+		{
+			// Outside the screen is still projected
+			Vector:   Cartesian{6, 12, 3},
+			Expected: Cartesian{-1, 0, 0.5},
+			OK:       true,
+		},
+		{
+			// Behind the camera
+			Vector: Cartesian{-4, 2, 3},
+			OK:     false,
+		},
+		{
+			// Level with the camera
+			Vector: Cartesian{1, 7, 3},
+			OK:     false,
+		},
+	}
+	for i, test := range cases {
+		actual, ok := c.ProjectToScreen(test.Vector)
+		if ok != test.OK {
+			t.Fatalf("ProjectToScreen %v failed:\n\tExpected ok: %v,\n\tActual ok: %v", i, test.OK, ok)
+		}
+		if ok && !CartesiansEqual(test.Expected, actual) {
+			t.Fatalf("ProjectToScreen %v failed. Cartesians were not equal:\n\tExpected: %v,\n\tActual: %v", i, test.Expected, actual)
+		}
+	}
+}
+
+func TestCameraUnproject(t *testing.T) {
+	c := newTestCamera()
+	c.LookAt(Cartesian{-3, 6, 0}, AxisY.Cartesian)
+	points := []Cartesian{
+		{-3, 6, 0},
+		{-1, 3, 2},
+		{-8, 4, -4},
+	}
+	for i, expected := range points {
+		screen, ok := c.ProjectToScreen(expected)
+		if !ok {
+			t.Fatalf("Unproject %v failed. Point was not in front of the camera", i)
+		}
+		actual, ok := c.Unproject(screen.X, screen.Y, screen.Z)
+		if !ok {
+			t.Fatalf("Unproject %v failed. Projection could not be inverted", i)
+		}
+		if !CartesiansEqual(expected, actual) {
+			t.Fatalf("Unproject %v failed. Cartesians were not equal:\n\tExpected: %v,\n\tActual: %v", i, expected, actual)
+		}
+	}
+
+	c.Near = 0
+	if _, ok := c.Unproject(0, 0, 0); ok {
+		t.Fatalf("Unproject failed. Projection with no near plane was inverted")
+	}
+}
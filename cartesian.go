@@ -32,10 +32,14 @@ func (c Cartesian) Spherical() Spherical {
 	y2 := c.Y * c.Y
 	z2 := c.Z * c.Z
 	r := math.Sqrt(x2 + y2 + z2)
-	if near(c.Y, 0) && near(c.X, 0) {
+	if c.X == 0 && c.Y == 0 {
+		// Points on the Z axis have no rotation (and the origin has no tilt)
+		if r == 0 {
+			return NewSpherical(r, 0, 0)
+		}
 		return NewSpherical(
 			r,
-			math.Atan(c.Y/c.X),
+			0,
 			math.Acos(c.Z/r),
 		)
 	}
@@ -67,20 +71,79 @@ func (c Cartesian) Scale(i float64) Vector {
 
 // Transform Multiplyiplies a Cartesian by a given matrix
 func (c Cartesian) Transform(m Matrix) Vector {
-	w := (c.X * m[3][0]) + (c.Y * m[3][1]) + (c.Z * m[3][2]) + (1 * m[3][3])
-	return Cartesian{
-		X: (c.X * m[0][0]) + (c.Y * m[0][1]) + (c.Z * m[0][2]) + (1 * m[0][3]),
-		Y: (c.X * m[1][0]) + (c.Y * m[1][1]) + (c.Z * m[1][2]) + (1 * m[1][3]),
-		Z: (c.X * m[2][0]) + (c.Y * m[2][1]) + (c.Z * m[2][2]) + (1 * m[2][3]),
-	}.Scale(1 / w)
+	return m.Apply(c)
 }
 
 // Project returns the projection of v onto c
 func (c Cartesian) Project(v Vector) Vector {
+	return c.Scale(c.Dot(v) / c.LengthSquared())
+}
+
+// Negate returns c pointing in the opposite direction
+func (c Cartesian) Negate() Vector {
+	return Cartesian{
+		X: -c.X,
+		Y: -c.Y,
+		Z: -c.Z,
+	}
+}
+
+// Subtract shifts a Cartesian by the negation of a Vector (subtraction)
+func (c Cartesian) Subtract(v Vector) Vector {
+	d := v.Cartesian()
+	return Cartesian{
+		X: c.X - d.X,
+		Y: c.Y - d.Y,
+		Z: c.Z - d.Z,
+	}
+}
+
+// Dot returns the dot product of c and v
+func (c Cartesian) Dot(v Vector) float64 {
+	d := v.Cartesian()
+	return (c.X * d.X) + (c.Y * d.Y) + (c.Z * d.Z)
+}
+
+// Cross returns the cross product of c and v (c x v)
+func (c Cartesian) Cross(v Vector) Vector {
+	d := v.Cartesian()
+	return Cartesian{
+		X: (c.Y * d.Z) - (c.Z * d.Y),
+		Y: (c.Z * d.X) - (c.X * d.Z),
+		Z: (c.X * d.Y) - (c.Y * d.X),
+	}
+}
+
+// Length returns the distance from the origin to c
+func (c Cartesian) Length() float64 {
+	return math.Sqrt(c.LengthSquared())
+}
+
+// LengthSquared returns the square of the distance from the origin to c
+func (c Cartesian) LengthSquared() float64 {
+	return (c.X * c.X) + (c.Y * c.Y) + (c.Z * c.Z)
+}
+
+// Normalize returns c scaled to a length of one
+// If c has no length, c is returned
+func (c Cartesian) Normalize() Vector {
+	length := c.Length()
+	if length == 0 {
+		return c
+	}
+	return c.Scale(1 / length)
+}
+
+// DistanceTo returns the distance between c and v
+func (c Cartesian) DistanceTo(v Vector) float64 {
+	return c.Subtract(v).Length()
+}
+
+// AngleTo returns the angle between c and v, in the range [0, pi]
+// If either c or v has no length, the angle is zero
+func (c Cartesian) AngleTo(v Vector) float64 {
 	d := v.Cartesian()
-	top := (d.X * c.X) + (d.Y * c.Y) + (d.Z * c.Z)
-	bot := (c.X * c.X) + (c.Y * c.Y) + (c.Z * c.Z)
-	return c.Scale(top / bot)
+	return math.Atan2(c.Cross(d).Length(), c.Dot(d))
 }
 
 // TranslationMatrix produces a matrix which will transform by v
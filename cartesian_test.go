@@ -1,6 +1,7 @@
 package space
 
 import (
+	"math/rand"
 	"testing"
 )
 
@@ -33,6 +34,48 @@ func CartesiansEqual(a, b Cartesian) bool {
 	return true
 }
 
+// randomCartesians returns n locations drawn from r, spread through the cube from min to max along each axis
+func randomCartesians(r *rand.Rand, n int, min, max float64) []Cartesian {
+	locations := make([]Cartesian, n)
+	for i := range locations {
+		locations[i] = Cartesian{
+			X: min + r.Float64()*(max-min),
+			Y: min + r.Float64()*(max-min),
+			Z: min + r.Float64()*(max-min),
+		}
+	}
+	return locations
+}
+
+// linearWithin finds the indices of the locations within radius of v by checking every location
+func linearWithin(locations []Cartesian, v Cartesian, radius float64) []int {
+	found := []int{}
+	for i, l := range locations {
+		if distanceSquared(l, v) <= radius*radius {
+			found = append(found, i)
+		}
+	}
+	return found
+}
+
+// IndexSetsEqual compares sets of indices, ignoring order
+func IndexSetsEqual(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	counts := map[int]int{}
+	for _, i := range a {
+		counts[i]++
+	}
+	for _, i := range b {
+		counts[i]--
+		if counts[i] < 0 {
+			return false
+		}
+	}
+	return true
+}
+
 func TestNewCartesian(t *testing.T) {
 	cases := []CartesianTest{
 		{
@@ -122,3 +165,31 @@ func TestCartesianTranslationMatrix(t *testing.T) {
 	}
 	RunCartesianTests(t, cases)
 }
+
+func TestCartesianSphericalRoundTrip(t *testing.T) {
+	cases := make([]CartesianTest, len(AllEquivalencies))
+	for i, p := range AllEquivalencies {
+		cases[i] = CartesianTest{
+			Initial: p.Cartesian,
+			Operation: func(v Cartesian) Cartesian {
+				return v.Spherical().Cartesian()
+			},
+			Expected: p.Cartesian,
+		}
+	}
+	// Short vectors just off the Z axis keep their rotation
+	cases = append(cases, CartesianTest{
+		Initial: Cartesian{0, 1e-7, 0},
+		Operation: func(v Cartesian) Cartesian {
+			return v.Spherical().Normalize().Cartesian()
+		},
+		Expected: AxisY.Cartesian,
+	}, CartesianTest{
+		Initial: Cartesian{-1e-7, 0, 1e-8},
+		Operation: func(v Cartesian) Cartesian {
+			return v.Spherical().Scale(1e7).Cartesian()
+		},
+		Expected: Cartesian{-1, 0, 0.1},
+	})
+	RunCartesianTests(t, cases)
+}
@@ -0,0 +1,412 @@
+package space
+
+import (
+	"math"
+	"sort"
+)
+
+// Curve is a smooth path through space
+// Curves are parameterized by t, which runs from 0 at the start of the curve to 1 at its end.
+type Curve interface {
+	// At returns the point on the curve at t
+	At(t float64) Cartesian
+	// Derivative returns the rate of change of At with respect to t
+	Derivative(t float64) Cartesian
+	// Bounds returns a box which contains the whole curve
+	Bounds() AABB
+}
+
+var (
+	_ Curve = Bezier{}
+	_ Curve = CatmullRom{}
+	_ Curve = BSpline{}
+)
+
+// Tangent returns the direction c is travelling at t, with a length of one
+// If c is not moving at t, the tangent has no length.
+func Tangent(c Curve, t float64) Cartesian {
+	return c.Derivative(t).Normalize().Cartesian()
+}
+
+// Sample returns n points on c, evenly spaced in t from the start of c to its end
+// The points are not evenly spaced in distance; see ArcLength.Sample.
+func Sample(c Curve, n int) []Cartesian {
+	if n <= 0 {
+		return nil
+	}
+	if n == 1 {
+		return []Cartesian{c.At(0)}
+	}
+	points := make([]Cartesian, n)
+	for i := range points {
+		points[i] = c.At(float64(i) / float64(n-1))
+	}
+	return points
+}
+
+// Bezier is a curve which is pulled towards each of its control points in turn
+// It starts at the first point and ends at the last, and its degree is one less than its number of points.
+type Bezier struct {
+	Points []Cartesian
+}
+
+// NewBezier creates a new Bezier from control points
+func NewBezier(points ...Cartesian) Bezier {
+	return Bezier{
+		Points: points,
+	}
+}
+
+// At returns the point on b at t
+func (b Bezier) At(t float64) Cartesian {
+	if len(b.Points) == 0 {
+		return Cartesian{}
+	}
+	// De Casteljau's algorithm
+	points := make([]Cartesian, len(b.Points))
+	copy(points, b.Points)
+	for n := len(points) - 1; n > 0; n-- {
+		for i := 0; i < n; i++ {
+			points[i] = Lerp(points[i], points[i+1], t)
+		}
+	}
+	return points[0]
+}
+
+// Derivative returns the rate of change of b with respect to t
+func (b Bezier) Derivative(t float64) Cartesian {
+	return b.hodograph().At(t)
+}
+
+// hodograph returns the Bezier which describes the derivative of b
+func (b Bezier) hodograph() Bezier {
+	if len(b.Points) < 2 {
+		return Bezier{}
+	}
+	degree := float64(len(b.Points) - 1)
+	points := make([]Cartesian, len(b.Points)-1)
+	for i := range points {
+		points[i] = b.Points[i+1].Subtract(b.Points[i]).Scale(degree).Cartesian()
+	}
+	return Bezier{
+		Points: points,
+	}
+}
+
+// Split divides b at t into two curves which together follow b
+// The first runs from the start of b to t, and the second from t to the end of b.
+func (b Bezier) Split(t float64) (Bezier, Bezier) {
+	n := len(b.Points)
+	first := make([]Cartesian, n)
+	second := make([]Cartesian, n)
+	points := make([]Cartesian, n)
+	copy(points, b.Points)
+	// The sides of De Casteljau's triangle are the control points of each half
+	for level := 0; level < n; level++ {
+		first[level] = points[0]
+		second[n-1-level] = points[n-1-level]
+		for i := 0; i < n-1-level; i++ {
+			points[i] = Lerp(points[i], points[i+1], t)
+		}
+	}
+	return Bezier{Points: first}, Bezier{Points: second}
+}
+
+// Bounds returns a box which contains b
+// The box contains all control points of b, so it may be larger than the curve.
+func (b Bezier) Bounds() AABB {
+	vectors := make([]Vector, len(b.Points))
+	for i, p := range b.Points {
+		vectors[i] = p
+	}
+	return NewAABBFromPoints(vectors...)
+}
+
+// CatmullRom is a curve which passes through each of its points in turn
+// Each pair of neighbouring points is joined by a uniform Catmull-Rom segment,
+// which leaves each point towards the next and arrives from the previous.
+// The first and last points are repeated so that the curve starts and ends at them.
+type CatmullRom struct {
+	Points []Cartesian
+}
+
+// NewCatmullRom creates a new CatmullRom through points
+func NewCatmullRom(points ...Cartesian) CatmullRom {
+	return CatmullRom{
+		Points: points,
+	}
+}
+
+// Beziers returns the cubic Bezier segments which make up c, in order
+func (c CatmullRom) Beziers() []Bezier {
+	return piecewiseBeziers(c)
+}
+
+// segments returns the number of Bezier segments which make up c
+func (c CatmullRom) segments() int {
+	if len(c.Points) < 2 {
+		return 1
+	}
+	return len(c.Points) - 1
+}
+
+// segment returns the Bezier segment of c from point i to point i+1
+func (c CatmullRom) segment(i int) Bezier {
+	if len(c.Points) < 2 {
+		return NewBezier(c.Points...)
+	}
+	// The first and last points stand in for the points beyond the ends
+	last := len(c.Points) - 1
+	p0, p1, p2, p3 := c.Points[clampIndex(i-1, last)], c.Points[i], c.Points[i+1], c.Points[clampIndex(i+2, last)]
+	return NewBezier(
+		p1,
+		p1.Translate(p2.Subtract(p0).Scale(1.0/6)).Cartesian(),
+		p2.Subtract(p3.Subtract(p1).Scale(1.0/6)).Cartesian(),
+		p2,
+	)
+}
+
+// At returns the point on c at t
+func (c CatmullRom) At(t float64) Cartesian {
+	return piecewiseAt(c, t)
+}
+
+// Derivative returns the rate of change of c with respect to t
+func (c CatmullRom) Derivative(t float64) Cartesian {
+	return piecewiseDerivative(c, t)
+}
+
+// Split divides c at t into the Bezier segments before and after t
+// A CatmullRom can't start or end partway along a segment, so the halves are Beziers.
+func (c CatmullRom) Split(t float64) ([]Bezier, []Bezier) {
+	return piecewiseSplit(c, t)
+}
+
+// Bounds returns a box which contains c
+// The box may be larger than the curve.
+func (c CatmullRom) Bounds() AABB {
+	return piecewiseBounds(c)
+}
+
+// BSpline is a uniform cubic B-spline, which is pulled towards each of its points in turn
+// Unlike a CatmullRom, it only passes near its points, but it curves more smoothly.
+// The first and last points are tripled so that the curve starts and ends at them.
+type BSpline struct {
+	Points []Cartesian
+}
+
+// NewBSpline creates a new BSpline from control points
+func NewBSpline(points ...Cartesian) BSpline {
+	return BSpline{
+		Points: points,
+	}
+}
+
+// Beziers returns the cubic Bezier segments which make up s, in order
+func (s BSpline) Beziers() []Bezier {
+	return piecewiseBeziers(s)
+}
+
+// segments returns the number of Bezier segments which make up s
+func (s BSpline) segments() int {
+	if len(s.Points) < 2 {
+		return 1
+	}
+	return len(s.Points) + 1
+}
+
+// segment returns the Bezier segment i of s
+func (s BSpline) segment(i int) Bezier {
+	if len(s.Points) < 2 {
+		return NewBezier(s.Points...)
+	}
+	// Segment i is shaped by points i-2 to i+1, where the tripled ends stand in for points beyond them
+	last := len(s.Points) - 1
+	p0, p1, p2, p3 := s.Points[clampIndex(i-2, last)], s.Points[clampIndex(i-1, last)], s.Points[clampIndex(i, last)], s.Points[clampIndex(i+1, last)]
+	return NewBezier(
+		weightedSum(p0, p1, p2, 1.0/6, 4.0/6, 1.0/6),
+		weightedSum(p1, p2, p2, 4.0/6, 2.0/6, 0),
+		weightedSum(p1, p2, p2, 2.0/6, 4.0/6, 0),
+		weightedSum(p1, p2, p3, 1.0/6, 4.0/6, 1.0/6),
+	)
+}
+
+// At returns the point on s at t
+func (s BSpline) At(t float64) Cartesian {
+	return piecewiseAt(s, t)
+}
+
+// Derivative returns the rate of change of s with respect to t
+func (s BSpline) Derivative(t float64) Cartesian {
+	return piecewiseDerivative(s, t)
+}
+
+// Split divides s at t into the Bezier segments before and after t
+// A BSpline can't start or end partway along a segment, so the halves are Beziers.
+func (s BSpline) Split(t float64) ([]Bezier, []Bezier) {
+	return piecewiseSplit(s, t)
+}
+
+// Bounds returns a box which contains s
+// The box may be larger than the curve.
+func (s BSpline) Bounds() AABB {
+	return piecewiseBounds(s)
+}
+
+// weightedSum returns (a * i) + (b * j) + (c * k)
+func weightedSum(a, b, c Cartesian, i, j, k float64) Cartesian {
+	return Cartesian{
+		X: (a.X * i) + (b.X * j) + (c.X * k),
+		Y: (a.Y * i) + (b.Y * j) + (c.Y * k),
+		Z: (a.Z * i) + (b.Z * j) + (c.Z * k),
+	}
+}
+
+// clampIndex returns i limited to the range [0, last]
+func clampIndex(i, last int) int {
+	if i < 0 {
+		return 0
+	}
+	if i > last {
+		return last
+	}
+	return i
+}
+
+// piecewise is a curve made of Bezier segments, which each take up an equal portion of t
+type piecewise interface {
+	segments() int
+	segment(i int) Bezier
+}
+
+// piecewiseSegment returns the index of the segment which t falls in,
+// and how far along that segment t is
+func piecewiseSegment(segments int, t float64) (int, float64) {
+	s := t * float64(segments)
+	i := int(math.Floor(s))
+	if i < 0 {
+		i = 0
+	} else if i > segments-1 {
+		i = segments - 1
+	}
+	return i, s - float64(i)
+}
+
+// piecewiseBeziers returns every segment of p, in order
+func piecewiseBeziers(p piecewise) []Bezier {
+	beziers := make([]Bezier, p.segments())
+	for i := range beziers {
+		beziers[i] = p.segment(i)
+	}
+	return beziers
+}
+
+// piecewiseAt returns the point at t on p
+func piecewiseAt(p piecewise, t float64) Cartesian {
+	i, local := piecewiseSegment(p.segments(), t)
+	return p.segment(i).At(local)
+}
+
+// piecewiseDerivative returns the derivative at t of p
+func piecewiseDerivative(p piecewise, t float64) Cartesian {
+	segments := p.segments()
+	i, local := piecewiseSegment(segments, t)
+	return p.segment(i).Derivative(local).Scale(float64(segments)).Cartesian()
+}
+
+// piecewiseSplit returns the segments of p before and after t, splitting the segment which t falls in
+func piecewiseSplit(p piecewise, t float64) ([]Bezier, []Bezier) {
+	segments := p.segments()
+	i, local := piecewiseSegment(segments, t)
+	before, after := p.segment(i).Split(local)
+	first := make([]Bezier, 0, i+1)
+	for j := 0; j < i; j++ {
+		first = append(first, p.segment(j))
+	}
+	second := make([]Bezier, 0, segments-i)
+	second = append(second, after)
+	for j := i + 1; j < segments; j++ {
+		second = append(second, p.segment(j))
+	}
+	return append(first, before), second
+}
+
+// piecewiseBounds returns a box which contains every segment of p
+func piecewiseBounds(p piecewise) AABB {
+	bounds := p.segment(0).Bounds()
+	for i := 1; i < p.segments(); i++ {
+		bounds = bounds.Union(p.segment(i).Bounds())
+	}
+	return bounds
+}
+
+// ArcLength measures distance along a Curve
+// Curves don't move at a constant speed as t increases, so ArcLength approximates
+// the curve with short straight segments to convert between t and distance.
+type ArcLength struct {
+	curve Curve
+	// distances are the distances along curve at evenly spaced values of t
+	distances []float64
+}
+
+// NewArcLength measures c with the given number of straight segments
+// More segments are more accurate, but take longer to measure.
+func NewArcLength(c Curve, segments int) ArcLength {
+	if segments < 1 {
+		segments = 1
+	}
+	distances := make([]float64, segments+1)
+	previous := c.At(0)
+	for i := 1; i <= segments; i++ {
+		point := c.At(float64(i) / float64(segments))
+		distances[i] = distances[i-1] + previous.DistanceTo(point)
+		previous = point
+	}
+	return ArcLength{
+		curve:     c,
+		distances: distances,
+	}
+}
+
+// Length returns the total length of the curve
+func (a ArcLength) Length() float64 {
+	return a.distances[len(a.distances)-1]
+}
+
+// T returns the t at which the curve has travelled distance
+// Distances beyond either end of the curve are limited to that end.
+func (a ArcLength) T(distance float64) float64 {
+	segments := len(a.distances) - 1
+	if distance <= 0 {
+		return 0
+	}
+	if distance >= a.Length() {
+		return 1
+	}
+	i := sort.SearchFloat64s(a.distances, distance)
+	// distances[i-1] < distance <= distances[i]
+	start, end := a.distances[i-1], a.distances[i]
+	portion := (distance - start) / (end - start)
+	return (float64(i-1) + portion) / float64(segments)
+}
+
+// At returns the point on the curve which is distance along it
+func (a ArcLength) At(distance float64) Cartesian {
+	return a.curve.At(a.T(distance))
+}
+
+// Sample returns n points on the curve, evenly spaced in distance from its start to its end
+func (a ArcLength) Sample(n int) []Cartesian {
+	if n <= 0 {
+		return nil
+	}
+	if n == 1 {
+		return []Cartesian{a.At(0)}
+	}
+	length := a.Length()
+	points := make([]Cartesian, n)
+	for i := range points {
+		points[i] = a.At(length * float64(i) / float64(n-1))
+	}
+	return points
+}
@@ -0,0 +1,303 @@
+package space
+
+import (
+	"testing"
+)
+
+var (
+	testBezier     = NewBezier(Cartesian{0, 0, 0}, Cartesian{1, 4, 0}, Cartesian{3, 4, 2}, Cartesian{4, 0, 2})
+	testCatmullRom = NewCatmullRom(Cartesian{0, 0, 0}, Cartesian{2, 2, 0}, Cartesian{4, 0, 1}, Cartesian{6, -2, 3}, Cartesian{8, 0, 0})
+	testBSpline    = NewBSpline(Cartesian{0, 0, 0}, Cartesian{2, 2, 0}, Cartesian{4, 0, 1}, Cartesian{6, -2, 3}, Cartesian{8, 0, 0})
+	testCurves     = []Curve{testBezier, testCatmullRom, testBSpline}
+)
+
+func TestBezierAt(t *testing.T) {
+	cases := []CartesianTest{
+		{
+			Operation: func(Cartesian) Cartesian {
+				return NewBezier(Cartesian{1, 2, 3}, Cartesian{3, 2, -1}).At(0.25)
+			},
+			Expected: Cartesian{1.5, 2, 2},
+		},
+		{
+			Operation: func(Cartesian) Cartesian {
+				return NewBezier(Cartesian{0, 0, 0}, Cartesian{1, 2, 0}, Cartesian{2, 0, 0}).At(0.5)
+			},
+			Expected: Cartesian{1, 1, 0},
+		},
+		{
+			Operation: func(Cartesian) Cartesian {
+				return testBezier.At(0)
+			},
+			Expected: Cartesian{0, 0, 0},
+		},
+		{
+			Operation: func(Cartesian) Cartesian {
+				return testBezier.At(1)
+			},
+			Expected: Cartesian{4, 0, 2},
+		},
+		{
+			Operation: func(Cartesian) Cartesian {
+				return testBezier.At(0.5)
+			},
+			Expected: Cartesian{2, 3, 1},
+		},
+		{
+			Operation: func(Cartesian) Cartesian {
+				return NewBezier(Cartesian{5, 5, 5}).At(0.5)
+			},
+			Expected: Cartesian{5, 5, 5},
+		},
+	}
+	RunCartesianTests(t, cases)
+}
+
+func TestBezierDerivative(t *testing.T) {
+	cases := []CartesianTest{
+		{
+			Operation: func(Cartesian) Cartesian {
+				return NewBezier(Cartesian{0, 0, 0}, Cartesian{1, 2, 0}, Cartesian{2, 0, 0}).Derivative(0)
+			},
+			Expected: Cartesian{2, 4, 0},
+		},
+		{
+			Operation: func(Cartesian) Cartesian {
+				return NewBezier(Cartesian{0, 0, 0}, Cartesian{1, 2, 0}, Cartesian{2, 0, 0}).Derivative(0.5)
+			},
+			Expected: Cartesian{2, 0, 0},
+		},
+		{
+			Operation: func(Cartesian) Cartesian {
+				return testBezier.Derivative(1)
+			},
+			Expected: Cartesian{3, -12, 0},
+		},
+		{
+			Operation: func(Cartesian) Cartesian {
+				return Tangent(testBezier, 1)
+			},
+			Expected: Cartesian{3, -12, 0}.Normalize().Cartesian(),
+		},
+		{
+			Operation: func(Cartesian) Cartesian {
+				return NewBezier(Cartesian{5, 5, 5}).Derivative(0.5)
+			},
+			Expected: Cartesian{0, 0, 0},
+		},
+	}
+	RunCartesianTests(t, cases)
+}
+
+func TestCurveDerivative(t *testing.T) {
+	// Derivatives match the change in position over a small step
+	const h = 0.000001
+	for i, c := range testCurves {
+		for _, at := range []float64{0.1, 0.3, 0.55, 0.8} {
+			expected := c.At(at + h).Subtract(c.At(at - h)).Scale(1 / (2 * h)).Cartesian()
+			actual := c.Derivative(at)
+			if expected.DistanceTo(actual) > 0.0001 {
+				t.Fatalf("Derivative %v failed at %v:\n\tExpected: %v,\n\tActual: %v", i, at, expected, actual)
+			}
+		}
+	}
+}
+
+func TestBezierSplit(t *testing.T) {
+	for _, at := range []float64{0, 0.3, 0.5, 1} {
+		first, second := testBezier.Split(at)
+		for _, s := range []float64{0, 0.2, 0.5, 0.9, 1} {
+			expected := testBezier.At(s * at)
+			if actual := first.At(s); !CartesiansEqual(expected, actual) {
+				t.Fatalf("Split at %v failed. First half at %v:\n\tExpected: %v,\n\tActual: %v", at, s, expected, actual)
+			}
+			expected = testBezier.At(at + s*(1-at))
+			if actual := second.At(s); !CartesiansEqual(expected, actual) {
+				t.Fatalf("Split at %v failed. Second half at %v:\n\tExpected: %v,\n\tActual: %v", at, s, expected, actual)
+			}
+		}
+	}
+}
+
+func TestCurveBounds(t *testing.T) {
+	for i, c := range testCurves {
+		b := c.Bounds()
+		for _, p := range Sample(c, 101) {
+			if !b.Contains(p) {
+				t.Fatalf("Bounds %v failed. %v was outside of %v", i, p, b)
+			}
+		}
+	}
+
+	expected := NewAABB(Cartesian{0, 0, 0}, Cartesian{4, 4, 2})
+	if actual := testBezier.Bounds(); !AABBsEqual(expected, actual) {
+		t.Fatalf("Bounds failed. AABBs were not equal:\n\tExpected: %v,\n\tActual: %v", expected, actual)
+	}
+}
+
+func TestCatmullRom(t *testing.T) {
+	points := testCatmullRom.Points
+	for i, expected := range points {
+		at := float64(i) / float64(len(points)-1)
+		if actual := testCatmullRom.At(at); !CartesiansEqual(expected, actual) {
+			t.Fatalf("CatmullRom failed. Curve missed point %v:\n\tExpected: %v,\n\tActual: %v", i, expected, actual)
+		}
+		if i == 0 || i == len(points)-1 {
+			continue
+		}
+		// Each point is passed heading from the previous point towards the next
+		expected = points[i+1].Subtract(points[i-1]).Normalize().Cartesian()
+		if actual := Tangent(testCatmullRom, at); !CartesiansEqual(expected, actual) {
+			t.Fatalf("CatmullRom failed. Tangent at point %v:\n\tExpected: %v,\n\tActual: %v", i, expected, actual)
+		}
+	}
+
+	if beziers := testCatmullRom.Beziers(); len(beziers) != len(points)-1 {
+		t.Fatalf("CatmullRom failed. Expected %v segments, got %v", len(points)-1, len(beziers))
+	}
+}
+
+func TestBSpline(t *testing.T) {
+	points := testBSpline.Points
+	if actual := testBSpline.At(0); !CartesiansEqual(points[0], actual) {
+		t.Fatalf("BSpline failed. Curve did not start at the first point:\n\tExpected: %v,\n\tActual: %v", points[0], actual)
+	}
+	if actual := testBSpline.At(1); !CartesiansEqual(points[len(points)-1], actual) {
+		t.Fatalf("BSpline failed. Curve did not end at the last point:\n\tExpected: %v,\n\tActual: %v", points[len(points)-1], actual)
+	}
+
+	// Segments meet with the same position, direction and curvature
+	beziers := testBSpline.Beziers()
+	for i := 1; i < len(beziers); i++ {
+		before, after := beziers[i-1], beziers[i]
+		if !CartesiansEqual(before.At(1), after.At(0)) {
+			t.Fatalf("BSpline failed. Segment %v did not meet the next", i)
+		}
+		if !CartesiansEqual(before.Derivative(1), after.Derivative(0)) {
+			t.Fatalf("BSpline failed. Segment %v changed direction", i)
+		}
+		if !CartesiansEqual(before.hodograph().Derivative(1), after.hodograph().Derivative(0)) {
+			t.Fatalf("BSpline failed. Segment %v changed curvature", i)
+		}
+	}
+
+	// A spline of points on a line stays on that line
+	line := NewBSpline(Cartesian{0, 0, 0}, Cartesian{1, 1, 1}, Cartesian{4, 4, 4})
+	for _, p := range Sample(line, 11) {
+		if !near(p.X, p.Y) || !near(p.Y, p.Z) {
+			t.Fatalf("BSpline failed. %v left the line", p)
+		}
+	}
+}
+
+func TestPiecewiseSplit(t *testing.T) {
+	curves := []struct {
+		Curve
+		Split    func(float64) ([]Bezier, []Bezier)
+		Segments int
+	}{
+		{testCatmullRom, testCatmullRom.Split, len(testCatmullRom.Beziers())},
+		{testBSpline, testBSpline.Split, len(testBSpline.Beziers())},
+	}
+	for i, c := range curves {
+		for _, at := range []float64{0, 0.3, 0.5, 0.77, 1} {
+			first, second := c.Split(at)
+			if len(first)+len(second) != c.Segments+1 {
+				t.Fatalf("Split %v at %v failed. Expected %v segments, got %v and %v", i, at, c.Segments+1, len(first), len(second))
+			}
+			split := c.At(at)
+			if !CartesiansEqual(split, first[len(first)-1].At(1)) || !CartesiansEqual(split, second[0].At(0)) {
+				t.Fatalf("Split %v at %v failed. Halves did not meet at %v", i, at, split)
+			}
+			if !CartesiansEqual(c.At(0), first[0].At(0)) || !CartesiansEqual(c.At(1), second[len(second)-1].At(1)) {
+				t.Fatalf("Split %v at %v failed. Halves did not reach the ends of the curve", i, at)
+			}
+			// Whole segments before the split follow the curve
+			for j, b := range first[:len(first)-1] {
+				expected := c.At((float64(j) + 0.5) / float64(c.Segments))
+				if actual := b.At(0.5); !CartesiansEqual(expected, actual) {
+					t.Fatalf("Split %v at %v failed. Segment %v:\n\tExpected: %v,\n\tActual: %v", i, at, j, expected, actual)
+				}
+			}
+		}
+	}
+}
+
+func TestPiecewiseAtAllocations(t *testing.T) {
+	// Only the segment at t is built, so long curves cost the same as short ones
+	long := make([]Cartesian, 200)
+	for i := range long {
+		long[i] = Cartesian{float64(i), float64(i % 3), 0}
+	}
+	curves := []struct {
+		Short, Long Curve
+	}{
+		{testCatmullRom, NewCatmullRom(long...)},
+		{testBSpline, NewBSpline(long...)},
+	}
+	for i, c := range curves {
+		short := testing.AllocsPerRun(10, func() { c.Short.At(0.4); c.Short.Derivative(0.4) })
+		actual := testing.AllocsPerRun(10, func() { c.Long.At(0.4); c.Long.Derivative(0.4) })
+		if actual > short {
+			t.Fatalf("At %v failed. Long curve allocated %v times, short curve %v times", i, actual, short)
+		}
+	}
+}
+
+func TestSample(t *testing.T) {
+	line := NewBezier(Cartesian{0, 0, 0}, Cartesian{4, 0, 0})
+	expected := []Cartesian{{0, 0, 0}, {1, 0, 0}, {2, 0, 0}, {3, 0, 0}, {4, 0, 0}}
+	actual := Sample(line, 5)
+	if len(actual) != len(expected) {
+		t.Fatalf("Sample failed. Expected %v points, got %v", len(expected), len(actual))
+	}
+	for i := range expected {
+		if !CartesiansEqual(expected[i], actual[i]) {
+			t.Fatalf("Sample %v failed. Cartesians were not equal:\n\tExpected: %v,\n\tActual: %v", i, expected[i], actual[i])
+		}
+	}
+
+	if actual := Sample(line, 1); len(actual) != 1 || !CartesiansEqual(actual[0], line.At(0)) {
+		t.Fatalf("Sample failed. Single sample was %v", actual)
+	}
+	if actual := Sample(line, 0); len(actual) != 0 {
+		t.Fatalf("Sample failed. Expected no points, got %v", actual)
+	}
+}
+
+func TestArcLength(t *testing.T) {
+	// This line speeds up and slows down, so t and distance are different
+	line := NewBezier(Cartesian{0, 0, 0}, Cartesian{9, 0, 0}, Cartesian{10, 0, 0})
+	a := NewArcLength(line, 1000)
+	if !near(a.Length(), 10) {
+		t.Fatalf("ArcLength failed. Length:\n\tExpected: %v,\n\tActual: %v", 10, a.Length())
+	}
+	cases := []struct {
+		Distance float64
+		Expected Cartesian
+	}{
+		{-1, Cartesian{0, 0, 0}},
+		{0, Cartesian{0, 0, 0}},
+		{2.5, Cartesian{2.5, 0, 0}},
+		{7, Cartesian{7, 0, 0}},
+		{10, Cartesian{10, 0, 0}},
+		{12, Cartesian{10, 0, 0}},
+	}
+	for i, c := range cases {
+		if actual := a.At(c.Distance); c.Expected.DistanceTo(actual) > 0.001 {
+			t.Fatalf("ArcLength %v failed. Cartesians were not equal:\n\tExpected: %v,\n\tActual: %v", i, c.Expected, actual)
+		}
+	}
+
+	// Evenly spaced samples are the same distance apart, even though the line changes speed
+	samples := a.Sample(9)
+	if len(samples) != 9 {
+		t.Fatalf("ArcLength Sample failed. Expected %v points, got %v", 9, len(samples))
+	}
+	for i, actual := range samples {
+		expected := Cartesian{1.25 * float64(i), 0, 0}
+		if expected.DistanceTo(actual) > 0.001 {
+			t.Fatalf("ArcLength Sample %v failed. Cartesians were not equal:\n\tExpected: %v,\n\tActual: %v", i, expected, actual)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package space
+
+import (
+	"fmt"
+	"math"
+)
+
+// Cylindrical represents a point in space in cylindrical coordinates
+type Cylindrical struct {
+	// R is distance from the Z axis
+	R float64
+	// T is rotation about Z
+	T float64
+	// Z is height above the XY plane
+	Z float64
+}
+
+var _ Vector = (*Cylindrical)(nil)
+
+// NewCylindrical creates a new Cylindrical from a radius, rotation and height
+func NewCylindrical(radius, theta, height float64) Cylindrical {
+	c := Cylindrical{
+		R: radius,
+		Z: height,
+	}
+	return c.Rotate(theta)
+}
+
+// Cylindrical returns the Cylindrical version of c
+func (c Cartesian) Cylindrical() Cylindrical {
+	return NewCylindrical(
+		math.Hypot(c.X, c.Y),
+		math.Atan2(c.Y, c.X),
+		c.Z,
+	)
+}
+
+// Cylindrical returns the Cylindrical version of s
+func (s Spherical) Cylindrical() Cylindrical {
+	sinP, cosP := math.Sincos(s.P)
+	c := Cylindrical{
+		R: s.R * sinP,
+		T: s.T,
+		Z: s.R * cosP,
+	}
+	// A negative radius (from scaling s by a negative) is kept positive
+	if c.R < 0 {
+		c.R = -c.R
+		return c.Rotate(math.Pi)
+	}
+	return c
+}
+
+// Cartesian returns the Cartesian version of c
+func (c Cylindrical) Cartesian() Cartesian {
+	sinT, cosT := math.Sincos(c.T)
+	return Cartesian{
+		X: c.R * cosT,
+		Y: c.R * sinT,
+		Z: c.Z,
+	}
+}
+
+// Spherical returns the Spherical version of c
+func (c Cylindrical) Spherical() Spherical {
+	return NewSpherical(
+		math.Hypot(c.R, c.Z),
+		c.T,
+		math.Atan2(c.R, c.Z),
+	)
+}
+
+// Cylindrical returns c
+func (c Cylindrical) Cylindrical() Cylindrical {
+	return c
+}
+
+// Translate shifts a Cylindrical by a Vector (addition in cartesian space)
+func (c Cylindrical) Translate(v Vector) Vector {
+	d := c.Cartesian()
+	return d.Translate(v)
+}
+
+// Scale scales a Cylindrical by i
+// R is kept positive by rotating half a turn when i is negative
+func (c Cylindrical) Scale(i float64) Vector {
+	c.Z *= i
+	if i < 0 {
+		c.R *= -i
+		return c.Rotate(math.Pi)
+	}
+	c.R *= i
+	return c
+}
+
+// Transform Multiplyiplies a Cylindrical by a given matrix
+func (c Cylindrical) Transform(m Matrix) Vector {
+	d := c.Cartesian()
+	return d.Transform(m)
+}
+
+// Project returns the projection of v onto c
+func (c Cylindrical) Project(v Vector) Vector {
+	d := c.Cartesian()
+	return d.Project(v)
+}
+
+// Negate returns c pointing in the opposite direction
+func (c Cylindrical) Negate() Vector {
+	c.Z = -c.Z
+	return c.Rotate(math.Pi)
+}
+
+// Subtract shifts a Cylindrical by the negation of a Vector (subtraction in cartesian space)
+func (c Cylindrical) Subtract(v Vector) Vector {
+	d := c.Cartesian()
+	return d.Subtract(v)
+}
+
+// Dot returns the dot product of c and v
+func (c Cylindrical) Dot(v Vector) float64 {
+	d := c.Cartesian()
+	return d.Dot(v)
+}
+
+// Cross returns the cross product of c and v (c x v)
+func (c Cylindrical) Cross(v Vector) Vector {
+	d := c.Cartesian()
+	return d.Cross(v)
+}
+
+// Length returns the distance from the origin to c
+func (c Cylindrical) Length() float64 {
+	return math.Hypot(c.R, c.Z)
+}
+
+// LengthSquared returns the square of the distance from the origin to c
+func (c Cylindrical) LengthSquared() float64 {
+	return (c.R * c.R) + (c.Z * c.Z)
+}
+
+// Normalize returns c scaled to a length of one
+// If c has no length, c is returned
+func (c Cylindrical) Normalize() Vector {
+	length := c.Length()
+	if length == 0 {
+		return c
+	}
+	return c.Scale(1 / length)
+}
+
+// DistanceTo returns the distance between c and v
+func (c Cylindrical) DistanceTo(v Vector) float64 {
+	d := c.Cartesian()
+	return d.DistanceTo(v)
+}
+
+// AngleTo returns the angle between c and v, in the range [0, pi]
+// If either c or v has no length, the angle is zero
+func (c Cylindrical) AngleTo(v Vector) float64 {
+	d := c.Cartesian()
+	return d.AngleTo(v)
+}
+
+// Rotate will adjust the rotation about Z by theta
+func (c Cylindrical) Rotate(theta float64) Cylindrical {
+	wrappedT := c.T + theta
+	unwrappedT := math.Mod(wrappedT, math.Pi*2)
+	if unwrappedT >= 0 {
+		c.T = unwrappedT
+	} else {
+		c.T = 2*math.Pi + unwrappedT
+	}
+	return c
+}
+
+func (c Cylindrical) String() string {
+	return fmt.Sprintf("{R:%4.2f, T:%4.2f, Z:%4.2f}", c.R, c.T, c.Z)
+}
@@ -0,0 +1,203 @@
+package space
+
+import (
+	"testing"
+)
+
+type CylindricalTest struct {
+	Initial   Cylindrical
+	Operation func(Cylindrical) Cylindrical
+	Expected  Cylindrical
+}
+
+func RunCylindricalTests(t *testing.T, cases []CylindricalTest) {
+	for i, c := range cases {
+		actual := c.Operation(c.Initial)
+		if !CylindricalsEqual(c.Expected, actual) {
+			t.Fatalf("Test %v failed. Cylindricals were not equal:\n\tExpected: %v,\n\tActual: %v", i, c.Expected, actual)
+		}
+	}
+}
+
+// CylindricalsEqual compares Cylindricals
+func CylindricalsEqual(a, b Cylindrical) bool {
+	if !near(a.Z, b.Z) {
+		return false
+	}
+	if !near(a.R, b.R) {
+		return false
+	}
+	// Handle Points which are close to z-axis with very different rotations
+	if near(a.R, 0) {
+		return true
+	}
+
+	if !near(a.T, b.T) {
+		// Handle Points which are close to 0 and 2pi theta
+		if near(a.T, b.T-rad(2, 1)) {
+			return true
+		}
+		if near(a.T-rad(2, 1), b.T) {
+			return true
+		}
+		return false
+	}
+	return true
+}
+
+func TestNewCylindrical(t *testing.T) {
+	cases := []CylindricalTest{
+		{
+			Operation: func(Cylindrical) Cylindrical {
+				return NewCylindrical(1, 0, 0)
+			},
+			Expected: Cylindrical{R: 1, T: 0, Z: 0},
+		},
+		{
+			Operation: func(Cylindrical) Cylindrical {
+				return NewCylindrical(2, rad(5, 2), -3)
+			},
+			Expected: Cylindrical{R: 2, T: rad(1, 2), Z: -3},
+		},
+		{
+			Operation: func(Cylindrical) Cylindrical {
+				return NewCylindrical(2, rad(-1, 2), 3)
+			},
+			Expected: Cylindrical{R: 2, T: rad(3, 2), Z: 3},
+		},
+	}
+	RunCylindricalTests(t, cases)
+}
+
+func TestCylindricalCartesian(t *testing.T) {
+	cases := make([]CartesianTest, len(AllEquivalencies))
+	for i, pair := range AllEquivalencies {
+		c := pair.Cylindrical
+		cases[i] = CartesianTest{
+			Operation: func(Cartesian) Cartesian {
+				return c.Cartesian()
+			},
+			Expected: pair.Cartesian,
+		}
+	}
+	RunCartesianTests(t, cases)
+}
+
+func TestCylindricalSpherical(t *testing.T) {
+	cases := make([]SphericalTest, len(AllEquivalencies))
+	for i, pair := range AllEquivalencies {
+		c := pair.Cylindrical
+		cases[i] = SphericalTest{
+			Operation: func(Spherical) Spherical {
+				return c.Spherical()
+			},
+			Expected: pair.Spherical,
+		}
+	}
+	RunSphericalTests(t, cases)
+}
+
+func TestCartesianCylindrical(t *testing.T) {
+	cases := make([]CylindricalTest, len(AllEquivalencies))
+	for i, pair := range AllEquivalencies {
+		c := pair.Cartesian
+		cases[i] = CylindricalTest{
+			Operation: func(Cylindrical) Cylindrical {
+				return c.Cylindrical()
+			},
+			Expected: pair.Cylindrical,
+		}
+	}
+	RunCylindricalTests(t, cases)
+}
+
+func TestSphericalCylindrical(t *testing.T) {
+	cases := make([]CylindricalTest, len(AllEquivalencies))
+	for i, pair := range AllEquivalencies {
+		s := pair.Spherical
+		cases[i] = CylindricalTest{
+			Operation: func(Cylindrical) Cylindrical {
+				return s.Cylindrical()
+			},
+			Expected: pair.Cylindrical,
+		}
+	}
+	RunCylindricalTests(t, cases)
+}
+
+func TestCylindricalRoundTrip(t *testing.T) {
+	cases := []CylindricalTest{}
+	for _, pair := range AllEquivalencies {
+		cases = append(cases,
+			CylindricalTest{
+				Initial: pair.Cylindrical,
+				Operation: func(c Cylindrical) Cylindrical {
+					return c.Cartesian().Cylindrical()
+				},
+				Expected: pair.Cylindrical,
+			},
+			CylindricalTest{
+				Initial: pair.Cylindrical,
+				Operation: func(c Cylindrical) Cylindrical {
+					return c.Spherical().Cylindrical()
+				},
+				Expected: pair.Cylindrical,
+			},
+		)
+	}
+	RunCylindricalTests(t, cases)
+}
+
+func TestCylindricalRotate(t *testing.T) {
+	cases := []VectorTest{
+		{
+			Initial: AxisX3.Cylindrical,
+			Operation: func(v Vector) Vector {
+				return v.Cylindrical().Rotate(rad(1, 2))
+			},
+			Expected: AxisY3,
+		},
+		{
+			Initial: OctantXYNZ.Cylindrical,
+			Operation: func(v Vector) Vector {
+				return v.Cylindrical().Rotate(rad(-3, 2))
+			},
+			Expected: OctantNXYNZ,
+		},
+		{
+			Initial: AxisZN3.Cylindrical,
+			Operation: func(v Vector) Vector {
+				return v.Cylindrical().Rotate(rad(1, 3))
+			},
+			Expected: AxisZN3,
+		},
+	}
+	RunVectorTests(t, cases)
+}
+
+func TestCylindricalScale(t *testing.T) {
+	cases := []VectorTest{
+		{
+			Initial: OctantXYZ.Cylindrical,
+			Operation: func(v Vector) Vector {
+				return v.Scale(3)
+			},
+			Expected: OctantXYZ3,
+		},
+		{
+			Initial: OctantXYZ3.Cylindrical,
+			Operation: func(v Vector) Vector {
+				return v.Scale(-1.0 / 3.0)
+			},
+			Expected: OctantNXNYNZ,
+		},
+		{
+			Initial: AxisZ3.Cylindrical,
+			Operation: func(v Vector) Vector {
+				return v.Scale(0)
+			},
+			Expected: Origin,
+		},
+	}
+	RunVectorTests(t, cases)
+}
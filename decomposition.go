@@ -0,0 +1,105 @@
+package space
+
+import (
+	"fmt"
+	"math"
+)
+
+// Decomposition is a transformation split into translation, rotation and scale
+// The transformation scales first, then rotates, then translates.
+type Decomposition struct {
+	// Translation is where the origin is moved to
+	Translation Cartesian
+	// Rotation is the rotation, as a matrix without translation or scale
+	Rotation Matrix
+	// Orientation is the direction which Rotation turns Z towards
+	Orientation Spherical
+	// Roll is the direction which Rotation turns Y towards
+	// Together with Orientation, it describes Rotation the way an Object does.
+	Roll Spherical
+	// Scale is the scale along each axis, before rotation
+	// When the transformation is mirrored, X is negative.
+	Scale Cartesian
+	// Sheared is true if the transformation can't be described without shear
+	// The shear is discarded, so the Decomposition only approximates the transformation.
+	Sheared bool
+	// Mirrored is true if the transformation turns right-handed space into left-handed space
+	Mirrored bool
+}
+
+// Decompose splits m into translation, rotation and scale
+// If m has a projection (the bottom row is not 0, 0, 0, 1) or flattens space
+// (an axis has no scale), m can't be decomposed and ok is false.
+func (m Matrix) Decompose() (d Decomposition, ok bool) {
+	if !near(m[3][0], 0) || !near(m[3][1], 0) || !near(m[3][2], 0) || !near(m[3][3], 1) {
+		return Decomposition{}, false
+	}
+	x := Cartesian{m[0][0], m[1][0], m[2][0]}
+	y := Cartesian{m[0][1], m[1][1], m[2][1]}
+	z := Cartesian{m[0][2], m[1][2], m[2][2]}
+
+	// An axis has no scale when it is short compared to the longest, whatever their lengths
+	flat := MinErr * math.Max(x.Length(), math.Max(y.Length(), z.Length()))
+
+	// Gram-Schmidt orthogonalization, which separates any shear from the scale
+	d.Scale.X = x.Length()
+	if d.Scale.X <= flat {
+		return Decomposition{}, false
+	}
+	x = x.Scale(1 / d.Scale.X).Cartesian()
+
+	shearXY := x.Dot(y)
+	y = y.Subtract(x.Scale(shearXY)).Cartesian()
+	d.Scale.Y = y.Length()
+	if d.Scale.Y <= flat {
+		return Decomposition{}, false
+	}
+	y = y.Scale(1 / d.Scale.Y).Cartesian()
+
+	shearXZ := x.Dot(z)
+	shearYZ := y.Dot(z)
+	z = z.Subtract(x.Scale(shearXZ)).Subtract(y.Scale(shearYZ)).Cartesian()
+	d.Scale.Z = z.Length()
+	if d.Scale.Z <= flat {
+		return Decomposition{}, false
+	}
+	z = z.Scale(1 / d.Scale.Z).Cartesian()
+
+	d.Sheared = !near(shearXY/d.Scale.Y, 0) || !near(shearXZ/d.Scale.Z, 0) || !near(shearYZ/d.Scale.Z, 0)
+
+	// Flipping X keeps Y and Z, which Orientation and Roll describe
+	if x.Dot(y.Cross(z)) < 0 {
+		d.Mirrored = true
+		d.Scale.X = -d.Scale.X
+		x = x.Negate().Cartesian()
+	}
+
+	d.Translation = Cartesian{m[0][3], m[1][3], m[2][3]}
+	d.Rotation = Matrix{
+		{x.X, y.X, z.X, 0},
+		{x.Y, y.Y, z.Y, 0},
+		{x.Z, y.Z, z.Z, 0},
+		{0, 0, 0, 1},
+	}
+	d.Orientation = z.Spherical()
+	d.Roll = y.Spherical()
+	return d, true
+}
+
+// Compose produces a matrix which will scale, then rotate, then translate by d
+// Compose reverses Decompose, unless the decomposed matrix was Sheared.
+func (d Decomposition) Compose() Matrix {
+	scale := NewScaleMatrix(d.Scale.X, d.Scale.Y, d.Scale.Z)
+	return d.Translation.TranslationMatrix().Multiply(d.Rotation).Multiply(scale)
+}
+
+// Object returns an Object with the translation and rotation of d
+// The scale of d is lost.
+func (d Decomposition) Object() *Object {
+	return NewObject(d.Translation, d.Orientation, d.Roll)
+}
+
+func (d Decomposition) String() string {
+	return fmt.Sprintf("{Translation:%v, Orientation:%v, Roll:%v, Scale:%v, Sheared:%v, Mirrored:%v}",
+		d.Translation, d.Orientation, d.Roll, d.Scale, d.Sheared, d.Mirrored)
+}
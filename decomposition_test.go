@@ -0,0 +1,144 @@
+package space
+
+import (
+	"testing"
+)
+
+func TestMatrixDecompose(t *testing.T) {
+	cases := []struct {
+		Object   *Object
+		Scale    Cartesian
+		Mirrored bool
+	}{
+		{
+			Object: NewObject(Origin.Cartesian, AxisZ.Spherical, AxisY.Spherical),
+			Scale:  Cartesian{1, 1, 1},
+		},
+		{
+			Object: NewObject(Cartesian{1, 2, 3}, AxisX.Spherical, AxisZ.Spherical),
+			Scale:  Cartesian{2, 3, 4},
+		},
+		{
+			Object: NewObject(Cartesian{-4, 0, 2}, OctantXNYZ.Spherical, AxisZ.Spherical),
+			Scale:  Cartesian{0.5, 0.5, 7},
+		},
+		{
+			Object:   NewObject(Cartesian{1, 2, 3}, OctantNXYNZ3.Spherical, AxisX.Spherical),
+			Scale:    Cartesian{-2, 3, 4},
+			Mirrored: true,
+		},
+	}
+	for i, c := range cases {
+		m := c.Object.Matrix().Multiply(NewScaleMatrix(c.Scale.X, c.Scale.Y, c.Scale.Z))
+		d, ok := m.Decompose()
+		if !ok {
+			t.Fatalf("Decompose %v failed. Matrix could not be decomposed", i)
+		}
+		if d.Sheared || d.Mirrored != c.Mirrored {
+			t.Fatalf("Decompose %v failed:\n\tExpected Mirrored: %v,\n\tActual: %v", i, c.Mirrored, d)
+		}
+		if !CartesiansEqual(c.Scale, d.Scale) {
+			t.Fatalf("Decompose %v failed. Scales were not equal:\n\tExpected: %v,\n\tActual: %v", i, c.Scale, d.Scale)
+		}
+		if !CartesiansEqual(c.Object.GetLocation(), d.Translation) {
+			t.Fatalf("Decompose %v failed. Translations were not equal:\n\tExpected: %v,\n\tActual: %v", i, c.Object.GetLocation(), d.Translation)
+		}
+		expected := c.Object.Quaternion().RotationMatrix()
+		if !MatriciesEqual(expected, d.Rotation) {
+			t.Fatalf("Decompose %v failed. Rotations were not equal:\n\tExpected: %v,\n\tActual: %v", i, expected, d.Rotation)
+		}
+		if actual := d.Object().Matrix(); !MatriciesEqual(c.Object.Matrix(), actual) {
+			t.Fatalf("Decompose %v failed. Object did not match:\n\tExpected: %v,\n\tActual: %v", i, c.Object, d.Object())
+		}
+		if actual := d.Compose(); !MatriciesEqual(m, actual) {
+			t.Fatalf("Compose %v failed. Matricies were not equal:\n\tExpected: %v,\n\tActual: %v", i, m, actual)
+		}
+	}
+}
+
+func TestMatrixDecomposeSpecial(t *testing.T) {
+	cases := []struct {
+		Matrix   Matrix
+		OK       bool
+		Sheared  bool
+		Mirrored bool
+	}{
+		{
+			// Mirroring across Y is a mirror across X and a half turn about Z
+			Matrix: Matrix{
+				{1, 0, 0, 0},
+				{0, -1, 0, 0},
+				{0, 0, 1, 0},
+				{0, 0, 0, 1},
+			},
+			OK:       true,
+			Mirrored: true,
+		},
+		{
+			Matrix: Matrix{
+				{1, 0.5, 0, 3},
+				{0, 1, 0, 0},
+				{0, 0, 1, 0},
+				{0, 0, 0, 1},
+			},
+			OK:      true,
+			Sheared: true,
+		},
+		{
+			Matrix: Matrix{
+				{2, 0, 0, 0},
+				{0, 2, 0, 0},
+				{0, 1, 2, 0},
+				{0, 0, 0, 1},
+			},
+			OK:      true,
+			Sheared: true,
+		},
+		{
+			Matrix: Matrix{
+				{1, 0, 0, 0},
+				{0, 0, 0, 0},
+				{0, 0, 1, 0},
+				{0, 0, 0, 1},
+			},
+			OK: false,
+		},
+		{
+			Matrix: NewPerspectiveMatrix(rad(1, 2), 1, 1, 10),
+			OK:     false,
+		},
+		{
+			// Small scales are still scales
+			Matrix: NewScaleMatrix(1e-7, 1e-7, 1e-7),
+			OK:     true,
+		},
+		{
+			Matrix: NewScaleMatrix(1e-7, 1e-14, 1e-7),
+			OK:     false,
+		},
+		{
+			Matrix: NewScaleMatrix(0, 0, 0),
+			OK:     false,
+		},
+	}
+	for i, c := range cases {
+		d, ok := c.Matrix.Decompose()
+		if ok != c.OK {
+			t.Fatalf("Decompose %v failed:\n\tExpected ok: %v,\n\tActual ok: %v", i, c.OK, ok)
+		}
+		if !ok {
+			continue
+		}
+		if d.Sheared != c.Sheared || d.Mirrored != c.Mirrored {
+			t.Fatalf("Decompose %v failed:\n\tExpected Sheared: %v, Mirrored: %v,\n\tActual: %v", i, c.Sheared, c.Mirrored, d)
+		}
+		if actual := d.Compose(); !d.Sheared && !MatriciesEqual(c.Matrix, actual) {
+			t.Fatalf("Compose %v failed. Matricies were not equal:\n\tExpected: %v,\n\tActual: %v", i, c.Matrix, actual)
+		}
+	}
+
+	d, _ := NewScaleMatrix(1e-7, 2e-7, 3e-7).Decompose()
+	if actual := d.Scale.Scale(1e7).Cartesian(); !CartesiansEqual(Cartesian{1, 2, 3}, actual) {
+		t.Fatalf("Decompose failed. Small scale was %v", d.Scale)
+	}
+}
@@ -0,0 +1,234 @@
+package space
+
+import (
+	"fmt"
+	"math"
+)
+
+// EulerOrder is the sequence of axes which EulerAngles rotate about
+type EulerOrder int
+
+// Tait-Bryan orders rotate about three different axes
+// Proper Euler orders rotate about the same axis first and last
+const (
+	EulerXYZ EulerOrder = iota
+	EulerXZY
+	EulerYXZ
+	EulerYZX
+	EulerZXY
+	EulerZYX
+
+	EulerXYX
+	EulerXZX
+	EulerYXY
+	EulerYZY
+	EulerZXZ
+	EulerZYZ
+)
+
+// axes returns the axes (0 for X, 1 for Y and 2 for Z) of o, in turn
+func (o EulerOrder) axes() [3]int {
+	switch o {
+	case EulerXYZ:
+		return [3]int{0, 1, 2}
+	case EulerXZY:
+		return [3]int{0, 2, 1}
+	case EulerYXZ:
+		return [3]int{1, 0, 2}
+	case EulerYZX:
+		return [3]int{1, 2, 0}
+	case EulerZXY:
+		return [3]int{2, 0, 1}
+	case EulerZYX:
+		return [3]int{2, 1, 0}
+	case EulerXYX:
+		return [3]int{0, 1, 0}
+	case EulerXZX:
+		return [3]int{0, 2, 0}
+	case EulerYXY:
+		return [3]int{1, 0, 1}
+	case EulerYZY:
+		return [3]int{1, 2, 1}
+	case EulerZXZ:
+		return [3]int{2, 0, 2}
+	case EulerZYZ:
+		return [3]int{2, 1, 2}
+	}
+	panic(fmt.Sprintf("space: unknown EulerOrder %d", int(o)))
+}
+
+func (o EulerOrder) String() string {
+	if o < EulerXYZ || o > EulerZYZ {
+		return fmt.Sprintf("EulerOrder(%d)", int(o))
+	}
+	names := "XYZ"
+	a := o.axes()
+	return string([]byte{names[a[0]], names[a[1]], names[a[2]]})
+}
+
+// EulerAngles represents a rotation as three rotations about principal axes
+//
+// Intrinsic rotations are each about the axes as moved by the previous rotations,
+// so intrinsic ZYX is yaw, then pitch, then roll.
+// Extrinsic rotations are each about the fixed world axes.
+// Intrinsic angles of one order are equivalent to extrinsic angles of the reversed order.
+//
+// At gimbal lock (the Second angle is at ±pi/2 for Tait-Bryan orders, or 0 or pi for
+// proper Euler orders) the First and Third axes line up and only their combination matters.
+// Conversions into EulerAngles then set Third to zero and give the whole rotation to First.
+type EulerAngles struct {
+	// First, Second and Third are the angles of rotation about each axis of Order, in turn
+	First, Second, Third float64
+	// Order is the sequence of axes which are rotated about
+	Order EulerOrder
+	// Extrinsic is true if the axes are fixed, and false if they move with each rotation
+	Extrinsic bool
+}
+
+// NewEulerAngles creates new EulerAngles
+func NewEulerAngles(first, second, third float64, order EulerOrder, extrinsic bool) EulerAngles {
+	return EulerAngles{
+		First:     first,
+		Second:    second,
+		Third:     third,
+		Order:     order,
+		Extrinsic: extrinsic,
+	}
+}
+
+// RotationMatrix produces a matrix which will rotate by e
+func (e EulerAngles) RotationMatrix() Matrix {
+	a := e.Order.axes()
+	first := newAxisRotationMatrix(a[0], e.First)
+	second := newAxisRotationMatrix(a[1], e.Second)
+	third := newAxisRotationMatrix(a[2], e.Third)
+	if e.Extrinsic {
+		return third.Multiply(second).Multiply(first)
+	}
+	return first.Multiply(second).Multiply(third)
+}
+
+// Quaternion returns the rotation described by e as a Quaternion
+func (e EulerAngles) Quaternion() Quaternion {
+	a := e.Order.axes()
+	first := newAxisQuaternion(a[0], e.First)
+	second := newAxisQuaternion(a[1], e.Second)
+	third := newAxisQuaternion(a[2], e.Third)
+	if e.Extrinsic {
+		return third.Multiply(second).Multiply(first)
+	}
+	return first.Multiply(second).Multiply(third)
+}
+
+// Spherical returns the direction which e turns Z towards
+func (e EulerAngles) Spherical() Spherical {
+	return e.Quaternion().Rotate(Cartesian{0, 0, 1}).Spherical()
+}
+
+// EulerAngles returns the rotation described by the upper 3x3 of m as EulerAngles
+func (m Matrix) EulerAngles(order EulerOrder, extrinsic bool) EulerAngles {
+	return m.Quaternion().EulerAngles(order, extrinsic)
+}
+
+// EulerAngles returns the rotation described by s (see RotationMatrix) as EulerAngles
+func (s Spherical) EulerAngles(order EulerOrder, extrinsic bool) EulerAngles {
+	return s.Quaternion().EulerAngles(order, extrinsic)
+}
+
+// EulerAngles returns the rotation described by q as EulerAngles
+func (q Quaternion) EulerAngles(order EulerOrder, extrinsic bool) EulerAngles {
+	// Bernardes and Viollet, "Quaternion to Euler angles conversion:
+	// A direct, general and computationally efficient method" (2022)
+	// finds extrinsic angles, so intrinsic angles are found in reverse.
+	q = q.Normalize()
+	a := order.axes()
+	i, j, k := a[0], a[1], a[2]
+	if !extrinsic {
+		i, k = k, i
+	}
+	proper := i == k
+	if proper {
+		k = 3 - i - j
+	}
+	sign := float64((i - j) * (j - k) * (k - i) / 2)
+
+	v := [3]float64{q.X, q.Y, q.Z}
+	var qa, qb, qc, qd float64
+	if proper {
+		qa, qb, qc, qd = q.W, v[i], v[j], v[k]*sign
+	} else {
+		qa, qb, qc, qd = q.W-v[j], v[i]+v[k]*sign, v[j]+q.W, v[k]*sign-v[i]
+	}
+
+	second := 2 * math.Atan2(math.Hypot(qc, qd), math.Hypot(qa, qb))
+	halfSum := math.Atan2(qb, qa)
+	halfDiff := math.Atan2(qd, qc)
+
+	var first, third float64
+	switch {
+	case near(second, 0) && extrinsic:
+		first = 2 * halfSum
+	case near(second, 0):
+		third = 2 * halfSum
+	case near(second, math.Pi) && extrinsic:
+		first = -2 * halfDiff
+	case near(second, math.Pi):
+		third = 2 * halfDiff
+	default:
+		first = halfSum - halfDiff
+		third = halfSum + halfDiff
+	}
+
+	if !proper {
+		third *= sign
+		second -= math.Pi / 2
+	}
+	if !extrinsic {
+		first, third = third, first
+	}
+	return EulerAngles{
+		First:     wrapAngle(first),
+		Second:    wrapAngle(second),
+		Third:     wrapAngle(third),
+		Order:     order,
+		Extrinsic: extrinsic,
+	}
+}
+
+func (e EulerAngles) String() string {
+	kind := "intrinsic"
+	if e.Extrinsic {
+		kind = "extrinsic"
+	}
+	return fmt.Sprintf("{%v %v, First:%4.2f, Second:%4.2f, Third:%4.2f}", kind, e.Order, e.First, e.Second, e.Third)
+}
+
+// newAxisRotationMatrix produces a matrix which will rotate about the axis (0 for X, 1 for Y and 2 for Z)
+func newAxisRotationMatrix(axis int, theta float64) Matrix {
+	switch axis {
+	case 0:
+		return NewRotationMatrixX(theta)
+	case 1:
+		return NewRotationMatrixY(theta)
+	default:
+		return NewRotationMatrixZ(theta)
+	}
+}
+
+// newAxisQuaternion creates a Quaternion which will rotate about the axis (0 for X, 1 for Y and 2 for Z)
+func newAxisQuaternion(axis int, theta float64) Quaternion {
+	v := [3]float64{}
+	v[axis] = 1
+	return NewAxisAngleQuaternion(Cartesian{v[0], v[1], v[2]}, theta)
+}
+
+// wrapAngle returns theta in the range (-pi, pi]
+func wrapAngle(theta float64) float64 {
+	theta = math.Mod(theta, 2*math.Pi)
+	if theta > math.Pi {
+		theta -= 2 * math.Pi
+	} else if theta <= -math.Pi {
+		theta += 2 * math.Pi
+	}
+	return theta
+}
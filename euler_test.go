@@ -0,0 +1,177 @@
+package space
+
+import (
+	"math"
+	"testing"
+)
+
+var allEulerOrders = []EulerOrder{
+	EulerXYZ,
+	EulerXZY,
+	EulerYXZ,
+	EulerYZX,
+	EulerZXY,
+	EulerZYX,
+	EulerXYX,
+	EulerXZX,
+	EulerYXY,
+	EulerYZY,
+	EulerZXZ,
+	EulerZYZ,
+}
+
+// EulerAnglesEqual compares EulerAngles
+func EulerAnglesEqual(a, b EulerAngles) bool {
+	if a.Order != b.Order || a.Extrinsic != b.Extrinsic {
+		return false
+	}
+	return near(wrapAngle(a.First-b.First), 0) &&
+		near(wrapAngle(a.Second-b.Second), 0) &&
+		near(wrapAngle(a.Third-b.Third), 0)
+}
+
+func TestEulerAnglesRotationMatrix(t *testing.T) {
+	cases := []CartesianTest{
+		{
+			// Yaw turns X towards Y
+			Initial: AxisX.Cartesian,
+			Operation: func(v Cartesian) Cartesian {
+				e := NewEulerAngles(rad(1, 2), 0, 0, EulerZYX, false)
+				return v.Transform(e.RotationMatrix()).Cartesian()
+			},
+			Expected: AxisY.Cartesian,
+		},
+		{
+			// Intrinsic yaw then pitch: pitch is about the yawed Y axis (world -X)
+			Initial: AxisY.Cartesian,
+			Operation: func(v Cartesian) Cartesian {
+				e := NewEulerAngles(rad(1, 2), rad(1, 2), 0, EulerZYX, false)
+				return v.Transform(e.RotationMatrix()).Cartesian()
+			},
+			Expected: AxisXN.Cartesian,
+		},
+		{
+			Initial: AxisZ.Cartesian,
+			Operation: func(v Cartesian) Cartesian {
+				e := NewEulerAngles(rad(1, 2), rad(1, 2), 0, EulerZYX, false)
+				return v.Transform(e.RotationMatrix()).Cartesian()
+			},
+			Expected: AxisY.Cartesian,
+		},
+		{
+			// Extrinsic yaw then pitch: pitch is about the world Y axis
+			Initial: AxisZ.Cartesian,
+			Operation: func(v Cartesian) Cartesian {
+				e := NewEulerAngles(rad(1, 2), rad(1, 2), 0, EulerZYX, true)
+				return v.Transform(e.RotationMatrix()).Cartesian()
+			},
+			Expected: AxisX.Cartesian,
+		},
+		{
+			Initial: OctantXNYZ3.Cartesian,
+			Operation: func(v Cartesian) Cartesian {
+				e := NewEulerAngles(rad(1, 3), rad(-2, 5), rad(3, 4), EulerZXZ, false)
+				return e.Quaternion().Rotate(v).Cartesian()
+			},
+			Expected: OctantXNYZ3.Cartesian.Transform(
+				NewEulerAngles(rad(1, 3), rad(-2, 5), rad(3, 4), EulerZXZ, false).RotationMatrix(),
+			).Cartesian(),
+		},
+	}
+	RunCartesianTests(t, cases)
+}
+
+func TestEulerAnglesRoundTrip(t *testing.T) {
+	angles := [][3]float64{
+		{0, 0, 0},
+		{rad(1, 3), rad(1, 5), rad(-3, 4)},
+		{rad(-5, 6), rad(2, 7), rad(1, 9)},
+		{rad(1, 1), rad(-1, 4), rad(1, 2)},
+	}
+	for _, order := range allEulerOrders {
+		for _, extrinsic := range []bool{false, true} {
+			for i, a := range angles {
+				second := a[1]
+				if order >= EulerXYX {
+					// Proper Euler angles have a Second in the range [0, pi]
+					second = math.Abs(second)
+				}
+				e := NewEulerAngles(a[0], second, a[2], order, extrinsic)
+				actual := e.RotationMatrix().EulerAngles(order, extrinsic)
+				if !EulerAnglesEqual(e, actual) {
+					t.Fatalf("RoundTrip %v failed. EulerAngles were not equal:\n\tExpected: %v,\n\tActual: %v", i, e, actual)
+				}
+				actual = e.Quaternion().EulerAngles(order, extrinsic)
+				if !EulerAnglesEqual(e, actual) {
+					t.Fatalf("RoundTrip %v failed. EulerAngles were not equal:\n\tExpected: %v,\n\tActual: %v", i, e, actual)
+				}
+			}
+		}
+	}
+}
+
+func TestEulerAnglesGimbalLock(t *testing.T) {
+	for _, order := range allEulerOrders {
+		locks := []float64{rad(1, 2), rad(-1, 2)}
+		if order >= EulerXYX {
+			locks = []float64{0, rad(1, 1)}
+		}
+		for _, extrinsic := range []bool{false, true} {
+			for i, lock := range locks {
+				e := NewEulerAngles(rad(1, 3), lock, rad(1, 5), order, extrinsic)
+				m := e.RotationMatrix()
+				actual := m.EulerAngles(order, extrinsic)
+				if !near(actual.Third, 0) {
+					t.Fatalf("GimbalLock %v failed. Third was not zero:\n\tInitial: %v,\n\tActual: %v", i, e, actual)
+				}
+				if !near(wrapAngle(actual.Second-lock), 0) {
+					t.Fatalf("GimbalLock %v failed. Second was not kept:\n\tInitial: %v,\n\tActual: %v", i, e, actual)
+				}
+				if !MatriciesEqual(m, actual.RotationMatrix()) {
+					t.Fatalf("GimbalLock %v failed. Rotations were not equal:\n\tInitial: %v,\n\tActual: %v", i, e, actual)
+				}
+			}
+		}
+	}
+}
+
+func TestEulerAnglesSpherical(t *testing.T) {
+	cases := []SphericalTest{}
+	for _, pair := range AllEquivalencies {
+		s := pair.Spherical
+		if near(s.R, 0) {
+			continue
+		}
+		cases = append(cases, SphericalTest{
+			Operation: func(Spherical) Spherical {
+				return s.EulerAngles(EulerZYX, false).Spherical()
+			},
+			Expected: s.Normalize().Spherical(),
+		})
+	}
+	cases = append(cases, SphericalTest{
+		Operation: func(Spherical) Spherical {
+			return NewEulerAngles(0, rad(1, 2), 0, EulerXYZ, false).Spherical()
+		},
+		Expected: AxisX.Spherical,
+	})
+	RunSphericalTests(t, cases)
+}
+
+func TestEulerOrderString(t *testing.T) {
+	cases := []struct {
+		Order    EulerOrder
+		Expected string
+	}{
+		{EulerXYZ, "XYZ"},
+		{EulerZYX, "ZYX"},
+		{EulerZXZ, "ZXZ"},
+		{EulerOrder(-1), "EulerOrder(-1)"},
+		{EulerOrder(12), "EulerOrder(12)"},
+	}
+	for i, c := range cases {
+		if actual := c.Order.String(); actual != c.Expected {
+			t.Fatalf("String %v failed:\n\tExpected: %v,\n\tActual: %v", i, c.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package space
+
+import (
+	"fmt"
+)
+
+// Containment describes how much of a shape is within a volume
+type Containment int
+
+const (
+	// Outside means no part of the shape is within the volume
+	Outside Containment = iota
+	// Intersecting means the shape crosses the boundary of the volume
+	Intersecting
+	// Inside means all of the shape is within the volume
+	Inside
+)
+
+func (c Containment) String() string {
+	switch c {
+	case Outside:
+		return "Outside"
+	case Intersecting:
+		return "Intersecting"
+	case Inside:
+		return "Inside"
+	}
+	return fmt.Sprintf("Containment(%d)", int(c))
+}
+
+// Frustum is the volume which is visible through a projection
+// Each plane faces into the frustum, so points inside are in front of all six planes.
+type Frustum struct {
+	// Planes are the left, right, bottom, top, near and far planes, in turn
+	Planes [6]Plane
+}
+
+// NewFrustum creates a new Frustum from a view-projection matrix
+// The frustum holds the points which m transforms into [-1, 1] on each axis.
+func NewFrustum(m Matrix) Frustum {
+	// Gribb and Hartmann, "Fast Extraction of Viewing Frustum Planes
+	// from the World-View-Projection Matrix" (2001)
+	rows := [6][4]float64{}
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 4; j++ {
+			rows[2*i][j] = m[3][j] + m[i][j]
+			rows[2*i+1][j] = m[3][j] - m[i][j]
+		}
+	}
+
+	f := Frustum{}
+	for i, r := range rows {
+		normal := Cartesian{r[0], r[1], r[2]}
+		length := normal.Length()
+		f.Planes[i] = Plane{
+			Normal: normal.Scale(1 / length).Cartesian(),
+			Offset: -r[3] / length,
+		}
+	}
+	return f
+}
+
+// Frustum returns the volume which is visible to the camera
+func (c Camera) Frustum() Frustum {
+	return NewFrustum(c.ViewProjectionMatrix())
+}
+
+// ContainsPoint returns whether v is inside f
+// Points on the boundary of f are Intersecting.
+func (f Frustum) ContainsPoint(v Vector) Containment {
+	result := Inside
+	for _, p := range f.Planes {
+		switch p.Side(v) {
+		case -1:
+			return Outside
+		case 0:
+			result = Intersecting
+		}
+	}
+	return result
+}
+
+// IntersectsSphere returns whether the sphere at center with radius is inside f
+// Spheres which are outside f, but near its corners, may be reported as Intersecting.
+func (f Frustum) IntersectsSphere(center Vector, radius float64) Containment {
+	result := Inside
+	for _, p := range f.Planes {
+		d := p.SignedDistance(center)
+		if d < -radius-MinErr {
+			return Outside
+		}
+		if d < radius-MinErr {
+			result = Intersecting
+		}
+	}
+	return result
+}
+
+// IntersectsBox returns whether b is inside f
+// Boxes which are outside f, but near its corners, may be reported as Intersecting.
+func (f Frustum) IntersectsBox(b AABB) Containment {
+	result := Inside
+	for _, p := range f.Planes {
+		// furthest is the corner of b furthest in front of p, and closest the corner furthest behind
+		furthest, closest := b.Min, b.Max
+		if p.Normal.X >= 0 {
+			furthest.X, closest.X = b.Max.X, b.Min.X
+		}
+		if p.Normal.Y >= 0 {
+			furthest.Y, closest.Y = b.Max.Y, b.Min.Y
+		}
+		if p.Normal.Z >= 0 {
+			furthest.Z, closest.Z = b.Max.Z, b.Min.Z
+		}
+		if p.SignedDistance(furthest) < -MinErr {
+			return Outside
+		}
+		if p.SignedDistance(closest) < MinErr {
+			result = Intersecting
+		}
+	}
+	return result
+}
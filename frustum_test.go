@@ -0,0 +1,108 @@
+package space
+
+import (
+	"testing"
+)
+
+// testBoxFrustum spans [-1, 1] on X and Y and [-3, -1] on Z
+var testBoxFrustum = NewFrustum(NewOrthographicMatrix(-1, 1, -1, 1, 1, 3))
+
+func TestNewFrustum(t *testing.T) {
+	expected := [6]Plane{
+		NewPlane(AxisX.Cartesian, -1),
+		NewPlane(AxisXN.Cartesian, -1),
+		NewPlane(AxisY.Cartesian, -1),
+		NewPlane(AxisYN.Cartesian, -1),
+		NewPlane(AxisZN.Cartesian, 1),
+		NewPlane(AxisZ.Cartesian, -3),
+	}
+	for i, p := range testBoxFrustum.Planes {
+		if !PlanesEqual(expected[i], p) {
+			t.Fatalf("NewFrustum %v failed. Planes were not equal:\n\tExpected: %v,\n\tActual: %v", i, expected[i], p)
+		}
+	}
+
+	// The corners of the camera's view are on the boundary of its frustum
+	c := newTestCamera()
+	f := c.Frustum()
+	for _, x := range []float64{-1, 1} {
+		for _, y := range []float64{-1, 1} {
+			for _, z := range []float64{-1, 1} {
+				corner, _ := c.Unproject(x, y, z)
+				if actual := f.ContainsPoint(corner); actual != Intersecting {
+					t.Fatalf("NewFrustum failed. Corner %v was %v", corner, actual)
+				}
+			}
+		}
+	}
+}
+
+func TestFrustumContainsPoint(t *testing.T) {
+	f := newTestCamera().Frustum()
+	cases := []struct {
+		Vector   Vector
+		Expected Containment
+	}{
+		{Cartesian{6, 2, 3}, Inside},
+		{Cartesian{6, -7, 7}, Inside},
+		{Cartesian{3, 2, 3}, Intersecting},
+		{Cartesian{6, 12, 3}, Intersecting},
+		{Cartesian{2, 2, 3}, Outside},
+		{Cartesian{12, 2, 3}, Outside},
+		{Cartesian{-4, 2, 3}, Outside},
+		{Cartesian{6, 2, 9}, Outside},
+	}
+	for i, c := range cases {
+		if actual := f.ContainsPoint(c.Vector); c.Expected != actual {
+			t.Fatalf("ContainsPoint %v failed:\n\tExpected: %v,\n\tActual: %v", i, c.Expected, actual)
+		}
+	}
+}
+
+func TestFrustumIntersectsSphere(t *testing.T) {
+	cases := []struct {
+		Center   Vector
+		Radius   float64
+		Expected Containment
+	}{
+		{Cartesian{0, 0, -2}, 0.5, Inside},
+		{Cartesian{0, 0, -2}, 1.2, Intersecting},
+		{Cartesian{0, 0, -2}, 5, Intersecting},
+		{Cartesian{1.5, 0, -2}, 1, Intersecting},
+		{Cartesian{2.5, 0, -2}, 1, Outside},
+		{Cartesian{0, 0, 1}, 1.5, Outside},
+	}
+	for i, c := range cases {
+		if actual := testBoxFrustum.IntersectsSphere(c.Center, c.Radius); c.Expected != actual {
+			t.Fatalf("IntersectsSphere %v failed:\n\tExpected: %v,\n\tActual: %v", i, c.Expected, actual)
+		}
+	}
+}
+
+func TestFrustumIntersectsBox(t *testing.T) {
+	cases := []struct {
+		Box      AABB
+		Expected Containment
+	}{
+		{NewAABB(Cartesian{-0.5, -0.5, -2.5}, Cartesian{0.5, 0.5, -1.5}), Inside},
+		{NewAABB(Cartesian{-1, -1, -3}, Cartesian{1, 1, -1}), Intersecting},
+		{NewAABB(Cartesian{-5, -5, -5}, Cartesian{5, 5, 5}), Intersecting},
+		{NewAABB(Cartesian{0.5, 0.5, -2}, Cartesian{3, 3, 0}), Intersecting},
+		{NewAABB(Cartesian{1.5, -1, -3}, Cartesian{3, 1, -1}), Outside},
+		{NewAABB(Cartesian{-1, -1, -6}, Cartesian{1, 1, -4}), Outside},
+	}
+	for i, c := range cases {
+		if actual := testBoxFrustum.IntersectsBox(c.Box); c.Expected != actual {
+			t.Fatalf("IntersectsBox %v failed:\n\tExpected: %v,\n\tActual: %v", i, c.Expected, actual)
+		}
+	}
+
+	// A device in front of the camera is visible, and one behind it is not
+	f := newTestCamera().Frustum()
+	if actual := f.IntersectsBox(NewAABB(Cartesian{5, 1, 2}, Cartesian{7, 3, 4})); actual != Inside {
+		t.Fatalf("IntersectsBox failed. Box in front of the camera was %v", actual)
+	}
+	if actual := f.IntersectsBox(NewAABB(Cartesian{-5, 1, 2}, Cartesian{-3, 3, 4})); actual != Outside {
+		t.Fatalf("IntersectsBox failed. Box behind the camera was %v", actual)
+	}
+}
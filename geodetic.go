@@ -0,0 +1,168 @@
+package space
+
+import (
+	"fmt"
+	"math"
+)
+
+// The WGS84 ellipsoid, which GPS coordinates are measured against
+const (
+	// WGS84SemiMajorAxis is the radius of the equator (meters)
+	WGS84SemiMajorAxis = 6378137.0
+	// WGS84Flattening is how much the poles are squashed towards the equator
+	WGS84Flattening = 1 / 298.257223563
+	// WGS84SemiMinorAxis is the distance from the center to a pole (meters)
+	WGS84SemiMinorAxis = WGS84SemiMajorAxis * (1 - WGS84Flattening)
+
+	// EarthMeanRadius is the radius of a sphere approximating the earth (meters)
+	EarthMeanRadius = 6371008.8
+)
+
+// wgs84E2 is the square of the first eccentricity of the WGS84 ellipsoid
+const wgs84E2 = WGS84Flattening * (2 - WGS84Flattening)
+
+// Geodetic represents a location on the earth by latitude, longitude and altitude
+// Angles are in radians and distances are in meters.
+type Geodetic struct {
+	// Latitude is the angle north of the equator
+	Latitude float64
+	// Longitude is the angle east of the prime meridian
+	Longitude float64
+	// Altitude is the height above the WGS84 ellipsoid
+	Altitude float64
+}
+
+// NewGeodetic creates a new Geodetic from latitude and longitude (radians) and altitude (meters)
+func NewGeodetic(latitude, longitude, altitude float64) Geodetic {
+	return Geodetic{
+		Latitude:  latitude,
+		Longitude: longitude,
+		Altitude:  altitude,
+	}
+}
+
+// NewGeodeticFromDegrees creates a new Geodetic from latitude and longitude (degrees) and altitude (meters)
+func NewGeodeticFromDegrees(latitude, longitude, altitude float64) Geodetic {
+	return Geodetic{
+		Latitude:  latitude * math.Pi / 180,
+		Longitude: longitude * math.Pi / 180,
+		Altitude:  altitude,
+	}
+}
+
+// NewGeodeticFromECEF creates a new Geodetic from earth-centered, earth-fixed coordinates
+func NewGeodeticFromECEF(v Vector) Geodetic {
+	// Heikkinen's closed form solution
+	c := v.Cartesian()
+	a := WGS84SemiMajorAxis
+	b := WGS84SemiMinorAxis
+	e2 := wgs84E2
+	ep2 := (a*a - b*b) / (b * b)
+
+	p := math.Hypot(c.X, c.Y)
+	z2 := c.Z * c.Z
+	F := 54 * b * b * z2
+	G := (p * p) + ((1 - e2) * z2) - (e2 * (a*a - b*b))
+	k := e2 * e2 * F * p * p / (G * G * G)
+	s := math.Cbrt(1 + k + math.Sqrt(k*k+2*k))
+	P := F / (3 * (s + 1/s + 1) * (s + 1/s + 1) * G * G)
+	Q := math.Sqrt(1 + 2*e2*e2*P)
+	// Over the poles, rounding can take the square root slightly below zero, where it should be zero
+	r0 := -(P*e2*p)/(1+Q) + math.Sqrt(math.Max(0, (a*a/2)*(1+1/Q)-(P*(1-e2)*z2)/(Q*(1+Q))-(P*p*p/2)))
+	U := math.Hypot(p-e2*r0, c.Z)
+	V := math.Sqrt((p-e2*r0)*(p-e2*r0) + (1-e2)*z2)
+	z0 := b * b * c.Z / (a * V)
+
+	return Geodetic{
+		Latitude:  math.Atan2(c.Z+ep2*z0, p),
+		Longitude: math.Atan2(c.Y, c.X),
+		Altitude:  U * (1 - (b * b / (a * V))),
+	}
+}
+
+// NewGeodeticFromENU creates a new Geodetic from a point in the East-North-Up frame at ref
+func NewGeodeticFromENU(ref Geodetic, v Vector) Geodetic {
+	return NewGeodeticFromECEF(v.Transform(ref.ENUMatrix().RigidInverse()))
+}
+
+// NewGeodeticFromNED creates a new Geodetic from a point in the North-East-Down frame at ref
+func NewGeodeticFromNED(ref Geodetic, v Vector) Geodetic {
+	return NewGeodeticFromECEF(v.Transform(ref.NEDMatrix().RigidInverse()))
+}
+
+// ECEF returns the earth-centered, earth-fixed Cartesian version of g
+// X points to the prime meridian at the equator, Z points to the north pole
+func (g Geodetic) ECEF() Cartesian {
+	sinLat, cosLat := math.Sincos(g.Latitude)
+	sinLon, cosLon := math.Sincos(g.Longitude)
+	// n is the radius of curvature in the prime vertical
+	n := WGS84SemiMajorAxis / math.Sqrt(1-wgs84E2*sinLat*sinLat)
+	return Cartesian{
+		X: (n + g.Altitude) * cosLat * cosLon,
+		Y: (n + g.Altitude) * cosLat * sinLon,
+		Z: (n*(1-wgs84E2) + g.Altitude) * sinLat,
+	}
+}
+
+// ENU returns g in the East-North-Up frame at ref
+func (g Geodetic) ENU(ref Geodetic) Cartesian {
+	return ref.ENUMatrix().Apply(g.ECEF())
+}
+
+// NED returns g in the North-East-Down frame at ref
+func (g Geodetic) NED(ref Geodetic) Cartesian {
+	return ref.NEDMatrix().Apply(g.ECEF())
+}
+
+// ENUMatrix produces a matrix which will transform from ECEF into the East-North-Up frame at g
+// X points east, Y points north and Z points up, with g at the origin
+func (g Geodetic) ENUMatrix() Matrix {
+	sinLat, cosLat := math.Sincos(g.Latitude)
+	sinLon, cosLon := math.Sincos(g.Longitude)
+	rotation := Matrix{
+		{-sinLon, cosLon, 0, 0},
+		{-sinLat * cosLon, -sinLat * sinLon, cosLat, 0},
+		{cosLat * cosLon, cosLat * sinLon, sinLat, 0},
+		{0, 0, 0, 1},
+	}
+	return rotation.Multiply(g.ECEF().Negate().Cartesian().TranslationMatrix())
+}
+
+// NEDMatrix produces a matrix which will transform from ECEF into the North-East-Down frame at g
+// X points north, Y points east and Z points down, with g at the origin
+func (g Geodetic) NEDMatrix() Matrix {
+	swap := Matrix{
+		{0, 1, 0, 0},
+		{1, 0, 0, 0},
+		{0, 0, -1, 0},
+		{0, 0, 0, 1},
+	}
+	return swap.Multiply(g.ENUMatrix())
+}
+
+// DistanceTo returns the great-circle distance from g to o along the surface of the earth
+// The earth is approximated as a sphere and altitude is ignored
+func (g Geodetic) DistanceTo(o Geodetic) float64 {
+	// Haversine formula
+	sinLat := math.Sin((o.Latitude - g.Latitude) / 2)
+	sinLon := math.Sin((o.Longitude - g.Longitude) / 2)
+	h := (sinLat * sinLat) + math.Cos(g.Latitude)*math.Cos(o.Latitude)*(sinLon*sinLon)
+	return 2 * EarthMeanRadius * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// BearingTo returns the initial bearing of the great-circle path from g to o
+// The bearing is clockwise from north, in the range [0, 2pi)
+func (g Geodetic) BearingTo(o Geodetic) float64 {
+	sinLat1, cosLat1 := math.Sincos(g.Latitude)
+	sinLat2, cosLat2 := math.Sincos(o.Latitude)
+	sinLon, cosLon := math.Sincos(o.Longitude - g.Longitude)
+	bearing := math.Atan2(sinLon*cosLat2, (cosLat1*sinLat2)-(sinLat1*cosLat2*cosLon))
+	if bearing < 0 {
+		bearing += 2 * math.Pi
+	}
+	return bearing
+}
+
+func (g Geodetic) String() string {
+	return fmt.Sprintf("{Latitude:%4.6f, Longitude:%4.6f, Altitude:%4.2f}", g.Latitude, g.Longitude, g.Altitude)
+}
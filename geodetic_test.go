@@ -0,0 +1,215 @@
+package space
+
+import (
+	"math"
+	"testing"
+)
+
+// GeodeticsEqual compares Geodetics
+func GeodeticsEqual(a, b Geodetic) bool {
+	if !near(a.Altitude, b.Altitude) {
+		return false
+	}
+	if !near(a.Latitude, b.Latitude) {
+		return false
+	}
+	// Handle Points which are at the poles with very different longitudes
+	if near(math.Abs(a.Latitude), rad(1, 2)) {
+		return true
+	}
+	return near(wrapAngle(a.Longitude-b.Longitude), 0)
+}
+
+var (
+	geodeticEquator   = NewGeodeticFromDegrees(0, 0, 0)
+	geodeticNorthPole = NewGeodeticFromDegrees(90, 0, 0)
+	geodeticNewYork   = NewGeodeticFromDegrees(40.7128, -74.0060, 10)
+	geodeticSydney    = NewGeodeticFromDegrees(-33.8688, 151.2093, 58)
+	geodeticEverest   = NewGeodeticFromDegrees(27.9881, 86.9250, 8848.86)
+)
+
+func TestGeodeticECEF(t *testing.T) {
+	cases := []CartesianTest{
+		{
+			Operation: func(Cartesian) Cartesian {
+				return geodeticEquator.ECEF()
+			},
+			Expected: Cartesian{WGS84SemiMajorAxis, 0, 0},
+		},
+		{
+			Operation: func(Cartesian) Cartesian {
+				return NewGeodeticFromDegrees(0, 90, 100).ECEF()
+			},
+			Expected: Cartesian{0, WGS84SemiMajorAxis + 100, 0},
+		},
+		{
+			Operation: func(Cartesian) Cartesian {
+				return geodeticNorthPole.ECEF()
+			},
+			Expected: Cartesian{0, 0, WGS84SemiMinorAxis},
+		},
+		{
+			Operation: func(Cartesian) Cartesian {
+				return NewGeodeticFromDegrees(-90, 0, -10).ECEF()
+			},
+			Expected: Cartesian{0, 0, -WGS84SemiMinorAxis + 10},
+		},
+	}
+	RunCartesianTests(t, cases)
+}
+
+func TestNewGeodeticFromECEF(t *testing.T) {
+	cases := []Geodetic{
+		geodeticEquator,
+		geodeticNorthPole,
+		geodeticNewYork,
+		geodeticSydney,
+		geodeticEverest,
+		NewGeodeticFromDegrees(-90, 0, 0),
+		NewGeodeticFromDegrees(12, 179.9, -400),
+		NewGeodeticFromDegrees(45, 45, 35786000),
+		NewGeodeticFromDegrees(90, 0, 100),
+		NewGeodeticFromDegrees(90, 0, -100),
+		NewGeodeticFromDegrees(-90, 0, 100),
+		NewGeodeticFromDegrees(-90, 0, -100),
+		NewGeodeticFromDegrees(89.99999999, 10, 50),
+		NewGeodeticFromDegrees(-89.99999999, -10, 50),
+	}
+	for i, expected := range cases {
+		actual := NewGeodeticFromECEF(expected.ECEF())
+		if !GeodeticsEqual(expected, actual) {
+			t.Fatalf("NewGeodeticFromECEF %v failed. Geodetics were not equal:\n\tExpected: %v,\n\tActual: %v", i, expected, actual)
+		}
+	}
+
+	poles := []struct {
+		ECEF     Cartesian
+		Expected Geodetic
+	}{
+		{Cartesian{0, 0, WGS84SemiMinorAxis + 100}, NewGeodeticFromDegrees(90, 0, 100)},
+		{Cartesian{0, 0, WGS84SemiMinorAxis - 100}, NewGeodeticFromDegrees(90, 0, -100)},
+		{Cartesian{0, 0, -WGS84SemiMinorAxis - 100}, NewGeodeticFromDegrees(-90, 0, 100)},
+	}
+	for i, c := range poles {
+		if actual := NewGeodeticFromECEF(c.ECEF); !GeodeticsEqual(c.Expected, actual) {
+			t.Fatalf("NewGeodeticFromECEF pole %v failed. Geodetics were not equal:\n\tExpected: %v,\n\tActual: %v", i, c.Expected, actual)
+		}
+	}
+}
+
+func TestGeodeticENU(t *testing.T) {
+	cases := []CartesianTest{
+		{
+			Operation: func(Cartesian) Cartesian {
+				return geodeticNewYork.ENU(geodeticNewYork)
+			},
+			Expected: Cartesian{0, 0, 0},
+		},
+		{
+			Operation: func(Cartesian) Cartesian {
+				above := geodeticNewYork
+				above.Altitude += 100
+				return above.ENU(geodeticNewYork)
+			},
+			Expected: Cartesian{0, 0, 100},
+		},
+		{
+			Operation: func(Cartesian) Cartesian {
+				above := geodeticNewYork
+				above.Altitude += 100
+				return above.NED(geodeticNewYork)
+			},
+			Expected: Cartesian{0, 0, -100},
+		},
+		{
+			// At the equator, east is Y in ECEF
+			Operation: func(Cartesian) Cartesian {
+				return Cartesian{WGS84SemiMajorAxis, 5, 7}.Transform(geodeticEquator.ENUMatrix()).Cartesian()
+			},
+			Expected: Cartesian{5, 7, 0},
+		},
+		{
+			Operation: func(Cartesian) Cartesian {
+				return Cartesian{WGS84SemiMajorAxis, 5, 7}.Transform(geodeticEquator.NEDMatrix()).Cartesian()
+			},
+			Expected: Cartesian{7, 5, 0},
+		},
+	}
+	RunCartesianTests(t, cases)
+
+	directions := []struct {
+		Geodetic Geodetic
+		East     bool
+		North    bool
+	}{
+		{NewGeodeticFromDegrees(40.7138, -74.0060, 10), false, true},
+		{NewGeodeticFromDegrees(40.7118, -74.0060, 10), false, false},
+		{NewGeodeticFromDegrees(40.7128, -74.0050, 10), true, false},
+	}
+	for i, d := range directions {
+		enu := d.Geodetic.ENU(geodeticNewYork)
+		if (enu.X > 1) != d.East || (enu.Y > 1) != d.North {
+			t.Fatalf("ENU %v failed. Point was in the wrong direction: %v", i, enu)
+		}
+	}
+}
+
+func TestNewGeodeticFromENU(t *testing.T) {
+	cases := []Geodetic{
+		geodeticEquator,
+		geodeticNewYork,
+		geodeticSydney,
+		geodeticEverest,
+	}
+	offset := Cartesian{120, -45, 12}
+	for i, ref := range cases {
+		enu := NewGeodeticFromENU(ref, offset)
+		if actual := enu.ENU(ref); !CartesiansEqual(offset, actual) {
+			t.Fatalf("NewGeodeticFromENU %v failed. Cartesians were not equal:\n\tExpected: %v,\n\tActual: %v", i, offset, actual)
+		}
+		ned := NewGeodeticFromNED(ref, offset)
+		if actual := ned.NED(ref); !CartesiansEqual(offset, actual) {
+			t.Fatalf("NewGeodeticFromNED %v failed. Cartesians were not equal:\n\tExpected: %v,\n\tActual: %v", i, offset, actual)
+		}
+	}
+}
+
+func TestGeodeticDistanceTo(t *testing.T) {
+	cases := []struct {
+		A, B     Geodetic
+		Expected float64
+	}{
+		{geodeticNewYork, geodeticNewYork, 0},
+		{geodeticEquator, NewGeodeticFromDegrees(0, 90, 0), EarthMeanRadius * rad(1, 2)},
+		{geodeticEquator, geodeticNorthPole, EarthMeanRadius * rad(1, 2)},
+		{NewGeodeticFromDegrees(0, -90, 0), NewGeodeticFromDegrees(0, 90, 0), EarthMeanRadius * rad(1, 1)},
+	}
+	for i, c := range cases {
+		if actual := c.A.DistanceTo(c.B); !near(c.Expected, actual) {
+			t.Fatalf("DistanceTo %v failed:\n\tExpected: %v,\n\tActual: %v", i, c.Expected, actual)
+		}
+	}
+
+	// New York to Sydney is about 15989km
+	if actual := geodeticNewYork.DistanceTo(geodeticSydney); math.Abs(actual-15989000) > 5000 {
+		t.Fatalf("DistanceTo failed. New York to Sydney was %v", actual)
+	}
+}
+
+func TestGeodeticBearingTo(t *testing.T) {
+	cases := []struct {
+		A, B     Geodetic
+		Expected float64
+	}{
+		{geodeticEquator, geodeticNorthPole, 0},
+		{geodeticEquator, NewGeodeticFromDegrees(0, 1, 0), rad(1, 2)},
+		{geodeticEquator, NewGeodeticFromDegrees(-1, 0, 0), rad(1, 1)},
+		{geodeticEquator, NewGeodeticFromDegrees(0, -1, 0), rad(3, 2)},
+		{geodeticNorthPole, geodeticEquator, rad(1, 1)},
+	}
+	for i, c := range cases {
+		if actual := c.A.BearingTo(c.B); !near(c.Expected, actual) {
+			t.Fatalf("BearingTo %v failed:\n\tExpected: %v,\n\tActual: %v", i, c.Expected, actual)
+		}
+	}
+}
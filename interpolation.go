@@ -0,0 +1,59 @@
+package space
+
+// Lerp linearly interpolates between a (t = 0) and b (t = 1)
+func Lerp(a, b Cartesian, t float64) Cartesian {
+	return Cartesian{
+		X: a.X + (b.X-a.X)*t,
+		Y: a.Y + (b.Y-a.Y)*t,
+		Z: a.Z + (b.Z-a.Z)*t,
+	}
+}
+
+// Slerp spherically interpolates between a (t = 0) and b (t = 1)
+// The direction moves at a constant rate along the great circle between a and b,
+// and the radius is interpolated linearly. If a and b are opposite, the great circle
+// is an arbitrary one which passes through both. If either has no length,
+// there is no direction to follow and the points are interpolated linearly.
+func Slerp(a, b Spherical, t float64) Spherical {
+	if a.R == 0 || b.R == 0 {
+		return Lerp(a.Cartesian(), b.Cartesian(), t).Spherical()
+	}
+	from := a.Normalize().Cartesian()
+	to := b.Normalize().Cartesian()
+	radius := a.Length() + (b.Length()-a.Length())*t
+
+	axis := from.Cross(to)
+	if near(axis.Length(), 0) {
+		if from.Dot(to) >= 0 {
+			return from.Scale(radius).Spherical()
+		}
+		axis = orthogonalAxis(from)
+	}
+	rotation := NewAxisAngleMatrix(axis, from.AngleTo(to)*t)
+	return rotation.Apply(from).Scale(radius).Spherical()
+}
+
+// AngularDistance returns the angle of the great circle arc between the directions of a and b
+// The angle is in the range [0, pi]. If either a or b has no length, the angle is zero.
+func AngularDistance(a, b Spherical) float64 {
+	return a.AngleTo(b)
+}
+
+// GreatCircleMidpoint returns the point halfway along the great circle arc between a and b
+func GreatCircleMidpoint(a, b Spherical) Spherical {
+	return Slerp(a, b, 0.5)
+}
+
+// GreatCircleIntermediatePoints returns n points which divide the great circle arc
+// between a and b into n+1 equal parts
+// a and b are not included.
+func GreatCircleIntermediatePoints(a, b Spherical, n int) []Spherical {
+	if n <= 0 {
+		return nil
+	}
+	points := make([]Spherical, n)
+	for i := range points {
+		points[i] = Slerp(a, b, float64(i+1)/float64(n+1))
+	}
+	return points
+}
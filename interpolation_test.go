@@ -0,0 +1,214 @@
+package space
+
+import (
+	"testing"
+)
+
+func TestLerp(t *testing.T) {
+	cases := []CartesianTest{
+		{
+			Initial: Cartesian{2, 3, 5},
+			Operation: func(v Cartesian) Cartesian {
+				return Lerp(v, Cartesian{4, -3, 5}, 0)
+			},
+			Expected: Cartesian{2, 3, 5},
+		},
+		{
+			Initial: Cartesian{2, 3, 5},
+			Operation: func(v Cartesian) Cartesian {
+				return Lerp(v, Cartesian{4, -3, 5}, 1)
+			},
+			Expected: Cartesian{4, -3, 5},
+		},
+		{
+			Initial: Cartesian{2, 3, 5},
+			Operation: func(v Cartesian) Cartesian {
+				return Lerp(v, Cartesian{4, -3, 5}, 0.25)
+			},
+			Expected: Cartesian{2.5, 1.5, 5},
+		},
+		{
+			// Extrapolation continues along the line
+			Initial: Cartesian{2, 3, 5},
+			Operation: func(v Cartesian) Cartesian {
+				return Lerp(v, Cartesian{4, -3, 5}, 2)
+			},
+			Expected: Cartesian{6, -9, 5},
+		},
+	}
+	RunCartesianTests(t, cases)
+}
+
+func TestSlerp(t *testing.T) {
+	cases := []SphericalTest{
+		{
+			Initial: AxisX.Spherical,
+			Operation: func(v Spherical) Spherical {
+				return Slerp(v, AxisY.Spherical, 0)
+			},
+			Expected: AxisX.Spherical,
+		},
+		{
+			Initial: AxisX.Spherical,
+			Operation: func(v Spherical) Spherical {
+				return Slerp(v, AxisY.Spherical, 1)
+			},
+			Expected: AxisY.Spherical,
+		},
+		{
+			Initial: AxisX.Spherical,
+			Operation: func(v Spherical) Spherical {
+				return Slerp(v, AxisY.Spherical, 0.5)
+			},
+			Expected: NewSpherical(1, rad(1, 4), rad(1, 2)),
+		},
+		{
+			// Radius is interpolated linearly
+			Initial: AxisX.Spherical,
+			Operation: func(v Spherical) Spherical {
+				return Slerp(v, AxisY3.Spherical, 0.5)
+			},
+			Expected: NewSpherical(2, rad(1, 4), rad(1, 2)),
+		},
+		{
+			// Short vectors still follow the arc
+			Initial: NewSpherical(1e-7, 0, rad(1, 2)),
+			Operation: func(v Spherical) Spherical {
+				s := Slerp(v, NewSpherical(1e-7, rad(1, 2), rad(1, 2)), 0.5)
+				s.R *= 1e7
+				return s
+			},
+			Expected: NewSpherical(1, rad(1, 4), rad(1, 2)),
+		},
+		{
+			// The shortest arc crosses T = 0
+			Initial: NewSpherical(1, rad(-1, 4), rad(1, 2)),
+			Operation: func(v Spherical) Spherical {
+				return Slerp(v, NewSpherical(1, rad(1, 4), rad(1, 2)), 0.5)
+			},
+			Expected: AxisX.Spherical,
+		},
+		{
+			// Over the pole
+			Initial: NewSpherical(1, 0, rad(1, 4)),
+			Operation: func(v Spherical) Spherical {
+				return Slerp(v, NewSpherical(1, rad(1, 1), rad(1, 4)), 0.5)
+			},
+			Expected: AxisZ.Spherical,
+		},
+		{
+			Initial: AxisZ.Spherical,
+			Operation: func(v Spherical) Spherical {
+				return Slerp(v, AxisZ3.Spherical, 0.5)
+			},
+			Expected: NewSpherical(2, 0, 0),
+		},
+		{
+			// Negative radius points the other way
+			Initial: Spherical{R: -1, T: 0, P: rad(1, 2)},
+			Operation: func(v Spherical) Spherical {
+				return Slerp(v, AxisY.Spherical, 0.5)
+			},
+			Expected: NewSpherical(1, rad(3, 4), rad(1, 2)),
+		},
+		{
+			Initial: Origin.Spherical,
+			Operation: func(v Spherical) Spherical {
+				return Slerp(v, AxisY3.Spherical, 0.5)
+			},
+			Expected: NewSpherical(1.5, rad(1, 2), rad(1, 2)),
+		},
+	}
+	RunSphericalTests(t, cases)
+
+	// Opposite directions still follow a great circle
+	a, b := AxisX.Spherical, AxisXN.Spherical
+	for i := 0; i <= 10; i++ {
+		s := Slerp(a, b, float64(i)/10)
+		if !near(s.R, 1) {
+			t.Fatalf("Slerp %v failed. Left the sphere: %v", i, s)
+		}
+		if expected := rad(i, 10); !near(expected, AngularDistance(a, s)) {
+			t.Fatalf("Slerp %v failed. Angle from a:\n\tExpected: %v,\n\tActual: %v", i, expected, AngularDistance(a, s))
+		}
+	}
+}
+
+func TestAngularDistance(t *testing.T) {
+	cases := []struct {
+		A, B     Spherical
+		Expected float64
+	}{
+		{AxisX.Spherical, AxisX3.Spherical, 0},
+		{AxisX.Spherical, AxisY.Spherical, rad(1, 2)},
+		{AxisX.Spherical, AxisXN3.Spherical, rad(1, 1)},
+		{AxisZ.Spherical, OctantXYZ.Spherical, OctantXYZ.Spherical.P},
+		{NewSpherical(1, rad(-1, 8), rad(1, 2)), NewSpherical(1, rad(1, 8), rad(1, 2)), rad(1, 4)},
+		{Origin.Spherical, AxisX.Spherical, 0},
+	}
+	for i, c := range cases {
+		if actual := AngularDistance(c.A, c.B); !near(c.Expected, actual) {
+			t.Fatalf("AngularDistance %v failed:\n\tExpected: %v,\n\tActual: %v", i, c.Expected, actual)
+		}
+	}
+}
+
+func TestGreatCircleMidpoint(t *testing.T) {
+	cases := []SphericalTest{
+		{
+			Initial: AxisX.Spherical,
+			Operation: func(v Spherical) Spherical {
+				return GreatCircleMidpoint(v, AxisZ.Spherical)
+			},
+			Expected: NewSpherical(1, 0, rad(1, 4)),
+		},
+		{
+			Initial: AxisY.Spherical,
+			Operation: func(v Spherical) Spherical {
+				return GreatCircleMidpoint(v, AxisZ3.Spherical)
+			},
+			Expected: NewSpherical(2, rad(1, 2), rad(1, 4)),
+		},
+	}
+	RunSphericalTests(t, cases)
+
+	// Any point a quarter turn from both is halfway between opposite points
+	m := GreatCircleMidpoint(AxisY.Spherical, AxisYN.Spherical)
+	if !near(AngularDistance(AxisY.Spherical, m), rad(1, 2)) {
+		t.Fatalf("GreatCircleMidpoint failed. Midpoint of opposite points was %v", m)
+	}
+}
+
+func TestGreatCircleIntermediatePoints(t *testing.T) {
+	points := GreatCircleIntermediatePoints(AxisX.Spherical, AxisY.Spherical, 2)
+	expected := []Spherical{
+		NewSpherical(1, rad(1, 6), rad(1, 2)),
+		NewSpherical(1, rad(1, 3), rad(1, 2)),
+	}
+	if len(points) != len(expected) {
+		t.Fatalf("GreatCircleIntermediatePoints failed. Expected %v points, got %v", len(expected), len(points))
+	}
+	for i := range expected {
+		if !SphericalsEqual(expected[i], points[i]) {
+			t.Fatalf("GreatCircleIntermediatePoints %v failed. Sphericals were not equal:\n\tExpected: %v,\n\tActual: %v", i, expected[i], points[i])
+		}
+	}
+
+	a, b := NewSpherical(2, rad(1, 3), rad(1, 5)), NewSpherical(2, rad(5, 4), rad(2, 3))
+	points = GreatCircleIntermediatePoints(a, b, 7)
+	step := AngularDistance(a, b) / 8
+	previous := a
+	for i, p := range append(points, b) {
+		if actual := AngularDistance(previous, p); !near(step, actual) {
+			t.Fatalf("GreatCircleIntermediatePoints %v failed. Uneven step:\n\tExpected: %v,\n\tActual: %v", i, step, actual)
+		}
+		if !near(AngularDistance(a, p)+AngularDistance(p, b), AngularDistance(a, b)) {
+			t.Fatalf("GreatCircleIntermediatePoints %v failed. Point %v left the great circle", i, p)
+		}
+		previous = p
+	}
+
+	if points := GreatCircleIntermediatePoints(a, b, 0); len(points) != 0 {
+		t.Fatalf("GreatCircleIntermediatePoints failed. Expected no points, got %v", points)
+	}
+}
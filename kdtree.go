@@ -0,0 +1,336 @@
+package space
+
+import (
+	"container/heap"
+	"sort"
+)
+
+// KDPoint is a location in a KDTree, with a payload which identifies what is there
+type KDPoint struct {
+	Location Cartesian
+	// Payload is any value attached to the location
+	// Payloads are compared with == by Delete. Payloads which can't be compared, such as slices,
+	// maps, funcs and structs holding them, never match, so points holding them can't be deleted.
+	Payload interface{}
+}
+
+// KDTree is a k-d tree, which finds points near a location without checking every point
+// Each level of the tree splits space across X, Y and Z, in turn.
+// Inserting many points after building can unbalance the tree; Rebuild balances it again.
+// KDTrees are not safe for concurrent use.
+type KDTree struct {
+	root *kdNode
+	size int
+}
+
+type kdNode struct {
+	point KDPoint
+	// axis is the axis which the node splits (0 for X, 1 for Y and 2 for Z)
+	// Points in left are at or below point along axis, and points in right are at or above it.
+	axis        int
+	left, right *kdNode
+}
+
+// NewKDTree creates a balanced KDTree holding points
+func NewKDTree(points ...KDPoint) *KDTree {
+	owned := make([]KDPoint, len(points))
+	copy(owned, points)
+	return &KDTree{
+		root: buildKDNode(owned, 0),
+		size: len(points),
+	}
+}
+
+// buildKDNode builds a balanced tree by splitting points at their median along each axis, in turn
+// points is reordered.
+func buildKDNode(points []KDPoint, depth int) *kdNode {
+	if len(points) == 0 {
+		return nil
+	}
+	axis := depth % 3
+	sort.Slice(points, func(i, j int) bool {
+		return axisValue(points[i].Location, axis) < axisValue(points[j].Location, axis)
+	})
+	median := len(points) / 2
+	return &kdNode{
+		point: points[median],
+		axis:  axis,
+		left:  buildKDNode(points[:median], depth+1),
+		right: buildKDNode(points[median+1:], depth+1),
+	}
+}
+
+// axisValue returns the value of c along axis (0 for X, 1 for Y and 2 for Z)
+func axisValue(c Cartesian, axis int) float64 {
+	switch axis {
+	case 0:
+		return c.X
+	case 1:
+		return c.Y
+	default:
+		return c.Z
+	}
+}
+
+// distanceSquared returns the square of the distance between a and b
+func distanceSquared(a, b Cartesian) float64 {
+	x, y, z := a.X-b.X, a.Y-b.Y, a.Z-b.Z
+	return (x * x) + (y * y) + (z * z)
+}
+
+// Len returns the number of points in t
+func (t *KDTree) Len() int {
+	return t.size
+}
+
+// Points returns every point in t, in no particular order
+func (t *KDTree) Points() []KDPoint {
+	points := make([]KDPoint, 0, t.size)
+	var walk func(n *kdNode)
+	walk = func(n *kdNode) {
+		if n == nil {
+			return
+		}
+		points = append(points, n.point)
+		walk(n.left)
+		walk(n.right)
+	}
+	walk(t.root)
+	return points
+}
+
+// Rebuild balances t
+func (t *KDTree) Rebuild() {
+	t.root = buildKDNode(t.Points(), 0)
+}
+
+// Insert adds p to t
+func (t *KDTree) Insert(p KDPoint) {
+	t.size++
+	if t.root == nil {
+		t.root = &kdNode{point: p}
+		return
+	}
+	n := t.root
+	for {
+		next := &n.right
+		if axisValue(p.Location, n.axis) < axisValue(n.point.Location, n.axis) {
+			next = &n.left
+		}
+		if *next == nil {
+			*next = &kdNode{
+				point: p,
+				axis:  (n.axis + 1) % 3,
+			}
+			return
+		}
+		n = *next
+	}
+}
+
+// Delete removes a point with the same location and payload as p from t
+// If there is no such point, t is not changed and false is returned.
+func (t *KDTree) Delete(p KDPoint) bool {
+	root, ok := deleteKDNode(t.root, p.Location, func(n *kdNode) bool {
+		return n.point.Location == p.Location && samePayload(n.point.Payload, p.Payload)
+	})
+	if ok {
+		t.root = root
+		t.size--
+	}
+	return ok
+}
+
+// deleteKDNode removes the first node under n which match accepts, and returns the new top of that tree
+// Only nodes on the same side of each split as location are searched.
+func deleteKDNode(n *kdNode, location Cartesian, match func(n *kdNode) bool) (*kdNode, bool) {
+	if n == nil {
+		return nil, false
+	}
+	if match(n) {
+		// The replacement is removed by identity, since its payload may not match itself
+		switch {
+		case n.right != nil:
+			// The smallest point on the right can replace n without breaking the split
+			replacement := minKDNode(n.right, n.axis)
+			n.point = replacement.point
+			n.right, _ = deleteKDNode(n.right, replacement.point.Location, isKDNode(replacement))
+		case n.left != nil:
+			// With nothing on the right, the smallest point on the left replaces n,
+			// and the rest of the left (which is at or above it) moves to the right
+			replacement := minKDNode(n.left, n.axis)
+			n.point = replacement.point
+			n.right, _ = deleteKDNode(n.left, replacement.point.Location, isKDNode(replacement))
+			n.left = nil
+		default:
+			return nil, true
+		}
+		return n, true
+	}
+
+	var ok bool
+	value, split := axisValue(location, n.axis), axisValue(n.point.Location, n.axis)
+	if value <= split {
+		n.left, ok = deleteKDNode(n.left, location, match)
+	}
+	if !ok && value >= split {
+		n.right, ok = deleteKDNode(n.right, location, match)
+	}
+	return n, ok
+}
+
+// isKDNode returns a match for deleteKDNode which only accepts node
+func isKDNode(node *kdNode) func(n *kdNode) bool {
+	return func(n *kdNode) bool {
+		return n == node
+	}
+}
+
+// samePayload returns true if a and b are equal
+// Payloads which can't be compared are never equal, rather than panicking.
+func samePayload(a, b interface{}) (same bool) {
+	defer func() {
+		if recover() != nil {
+			same = false
+		}
+	}()
+	return a == b
+}
+
+// minKDNode returns the node under n which is smallest along axis
+func minKDNode(n *kdNode, axis int) *kdNode {
+	if n == nil {
+		return nil
+	}
+	smallest := n
+	if c := minKDNode(n.left, axis); c != nil && axisValue(c.point.Location, axis) < axisValue(smallest.point.Location, axis) {
+		smallest = c
+	}
+	// Splits on axis order the points along it, so nothing on the right can be smaller
+	if n.axis == axis {
+		return smallest
+	}
+	if c := minKDNode(n.right, axis); c != nil && axisValue(c.point.Location, axis) < axisValue(smallest.point.Location, axis) {
+		smallest = c
+	}
+	return smallest
+}
+
+// Nearest returns the point in t which is closest to v
+// If t is empty, ok is false.
+func (t *KDTree) Nearest(v Vector) (nearest KDPoint, ok bool) {
+	points := t.NearestK(v, 1)
+	if len(points) == 0 {
+		return KDPoint{}, false
+	}
+	return points[0], true
+}
+
+// NearestK returns the k points in t which are closest to v, from closest to furthest
+// If t has fewer than k points, all of them are returned.
+func (t *KDTree) NearestK(v Vector, k int) []KDPoint {
+	if k <= 0 {
+		return nil
+	}
+	c := v.Cartesian()
+	found := &kdCandidates{}
+	var search func(n *kdNode)
+	search = func(n *kdNode) {
+		if n == nil {
+			return
+		}
+		d := distanceSquared(n.point.Location, c)
+		if found.Len() < k {
+			heap.Push(found, kdCandidate{point: n.point, distanceSquared: d})
+		} else if d < (*found)[0].distanceSquared {
+			(*found)[0] = kdCandidate{point: n.point, distanceSquared: d}
+			heap.Fix(found, 0)
+		}
+
+		offset := axisValue(c, n.axis) - axisValue(n.point.Location, n.axis)
+		closer, further := n.left, n.right
+		if offset >= 0 {
+			closer, further = n.right, n.left
+		}
+		search(closer)
+		// The further side can only hold closer points if the split is closer than the furthest found
+		if found.Len() < k || offset*offset < (*found)[0].distanceSquared {
+			search(further)
+		}
+	}
+	search(t.root)
+
+	points := make([]KDPoint, found.Len())
+	for i := len(points) - 1; i >= 0; i-- {
+		points[i] = heap.Pop(found).(kdCandidate).point
+	}
+	return points
+}
+
+// Within returns the points in t which are no further than radius from v, in no particular order
+func (t *KDTree) Within(v Vector, radius float64) []KDPoint {
+	c := v.Cartesian()
+	radiusSquared := radius * radius
+	points := []KDPoint{}
+	var search func(n *kdNode)
+	search = func(n *kdNode) {
+		if n == nil {
+			return
+		}
+		if distanceSquared(n.point.Location, c) <= radiusSquared {
+			points = append(points, n.point)
+		}
+		offset := axisValue(c, n.axis) - axisValue(n.point.Location, n.axis)
+		if offset <= radius {
+			search(n.left)
+		}
+		if offset >= -radius {
+			search(n.right)
+		}
+	}
+	search(t.root)
+	return points
+}
+
+// InBox returns the points in t which are inside or on the surface of b, in no particular order
+func (t *KDTree) InBox(b AABB) []KDPoint {
+	points := []KDPoint{}
+	var search func(n *kdNode)
+	search = func(n *kdNode) {
+		if n == nil {
+			return
+		}
+		if b.Contains(n.point.Location) {
+			points = append(points, n.point)
+		}
+		split := axisValue(n.point.Location, n.axis)
+		if axisValue(b.Min, n.axis) <= split+MinErr {
+			search(n.left)
+		}
+		if axisValue(b.Max, n.axis) >= split-MinErr {
+			search(n.right)
+		}
+	}
+	search(t.root)
+	return points
+}
+
+// kdCandidate is a point found by NearestK
+type kdCandidate struct {
+	point           KDPoint
+	distanceSquared float64
+}
+
+// kdCandidates is a heap of candidates with the furthest first
+type kdCandidates []kdCandidate
+
+func (c kdCandidates) Len() int            { return len(c) }
+func (c kdCandidates) Less(i, j int) bool  { return c[i].distanceSquared > c[j].distanceSquared }
+func (c kdCandidates) Swap(i, j int)       { c[i], c[j] = c[j], c[i] }
+func (c *kdCandidates) Push(x interface{}) { *c = append(*c, x.(kdCandidate)) }
+func (c *kdCandidates) Pop() interface{} {
+	old := *c
+	last := old[len(old)-1]
+	*c = old[:len(old)-1]
+	return last
+}
@@ -0,0 +1,336 @@
+package space
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// randomKDPoints returns n points spread through a 100 unit cube, with their index as payload
+// Locations are rounded so that some points share a location, or a value along an axis.
+func randomKDPoints(n int, seed int64) []KDPoint {
+	points := make([]KDPoint, n)
+	for i, l := range randomCartesians(rand.New(rand.NewSource(seed)), n, 0, 100) {
+		points[i] = KDPoint{
+			Location: Cartesian{math.Floor(l.X), math.Floor(l.Y), math.Floor(l.Z)},
+			Payload:  i,
+		}
+	}
+	return points
+}
+
+// kdLocations returns the locations of points
+func kdLocations(points []KDPoint) []Cartesian {
+	locations := make([]Cartesian, len(points))
+	for i, p := range points {
+		locations[i] = p.Location
+	}
+	return locations
+}
+
+// kdIndices returns the payloads of points made by randomKDPoints, which are their indices
+func kdIndices(points []KDPoint) []int {
+	indices := make([]int, len(points))
+	for i, p := range points {
+		indices[i] = p.Payload.(int)
+	}
+	return indices
+}
+
+// kdWithin finds the payloads of the points within radius of v by checking every point
+func kdWithin(points []KDPoint, v Cartesian, radius float64) []int {
+	payloads := []int{}
+	for _, i := range linearWithin(kdLocations(points), v, radius) {
+		payloads = append(payloads, points[i].Payload.(int))
+	}
+	return payloads
+}
+
+// linearNearestK finds the k points closest to v by checking every point
+func linearNearestK(points []KDPoint, v Cartesian, k int) []KDPoint {
+	sorted := make([]KDPoint, len(points))
+	copy(sorted, points)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return distanceSquared(sorted[i].Location, v) < distanceSquared(sorted[j].Location, v)
+	})
+	if k > len(sorted) {
+		k = len(sorted)
+	}
+	return sorted[:k]
+}
+
+var kdQueries = []Cartesian{
+	{50, 50, 50},
+	{0, 0, 0},
+	{-20, 130, 40},
+	{12.5, 77.3, 3.9},
+	{99, 1, 50},
+}
+
+func TestNewKDTree(t *testing.T) {
+	points := randomKDPoints(500, 1)
+	tree := NewKDTree(points...)
+	if tree.Len() != len(points) {
+		t.Fatalf("NewKDTree failed. Expected %v points, got %v", len(points), tree.Len())
+	}
+	if !IndexSetsEqual(kdIndices(points), kdIndices(tree.Points())) {
+		t.Fatalf("NewKDTree failed. Points were not equal")
+	}
+
+	empty := NewKDTree()
+	if _, ok := empty.Nearest(Cartesian{}); ok || empty.Len() != 0 {
+		t.Fatalf("NewKDTree failed. Empty tree found a point")
+	}
+}
+
+func TestKDTreeNearestK(t *testing.T) {
+	points := randomKDPoints(500, 2)
+	tree := NewKDTree(points...)
+	for i, q := range kdQueries {
+		for _, k := range []int{1, 5, 40} {
+			expected := linearNearestK(points, q, k)
+			actual := tree.NearestK(q, k)
+			if len(expected) != len(actual) {
+				t.Fatalf("NearestK %v failed. Expected %v points, got %v", i, len(expected), len(actual))
+			}
+			// Points at the same distance may be found in either order
+			for j := range expected {
+				if !near(distanceSquared(expected[j].Location, q), distanceSquared(actual[j].Location, q)) {
+					t.Fatalf("NearestK %v failed. Point %v was at the wrong distance:\n\tExpected: %v,\n\tActual: %v", i, j, expected[j], actual[j])
+				}
+			}
+		}
+
+		nearest, ok := tree.Nearest(q)
+		if !ok || !near(distanceSquared(nearest.Location, q), distanceSquared(linearNearestK(points, q, 1)[0].Location, q)) {
+			t.Fatalf("Nearest %v failed. Found %v", i, nearest)
+		}
+	}
+
+	if actual := NewKDTree(points[:3]...).NearestK(Cartesian{}, 10); len(actual) != 3 {
+		t.Fatalf("NearestK failed. Expected %v points, got %v", 3, len(actual))
+	}
+	if actual := tree.NearestK(Cartesian{}, 0); len(actual) != 0 {
+		t.Fatalf("NearestK failed. Expected no points, got %v", actual)
+	}
+}
+
+func TestKDTreeWithin(t *testing.T) {
+	points := randomKDPoints(500, 3)
+	tree := NewKDTree(points...)
+	for i, q := range kdQueries {
+		for _, radius := range []float64{0, 5, 20, 60} {
+			expected := kdWithin(points, q, radius)
+			if actual := kdIndices(tree.Within(q, radius)); !IndexSetsEqual(expected, actual) {
+				t.Fatalf("Within %v failed at radius %v:\n\tExpected: %v,\n\tActual: %v", i, radius, expected, actual)
+			}
+		}
+	}
+}
+
+func TestKDTreeInBox(t *testing.T) {
+	points := randomKDPoints(500, 4)
+	tree := NewKDTree(points...)
+	boxes := []AABB{
+		NewAABB(Cartesian{0, 0, 0}, Cartesian{100, 100, 100}),
+		NewAABB(Cartesian{10, 20, 30}, Cartesian{40, 50, 60}),
+		NewAABB(Cartesian{50, 50, 50}, Cartesian{50, 50, 50}),
+		NewAABB(Cartesian{-10, -10, -10}, Cartesian{-1, -1, -1}),
+	}
+	for i, b := range boxes {
+		expected := []int{}
+		for j, p := range points {
+			if b.Contains(p.Location) {
+				expected = append(expected, j)
+			}
+		}
+		if actual := kdIndices(tree.InBox(b)); !IndexSetsEqual(expected, actual) {
+			t.Fatalf("InBox %v failed:\n\tExpected: %v,\n\tActual: %v", i, expected, actual)
+		}
+	}
+}
+
+func TestKDTreeInsertDelete(t *testing.T) {
+	points := randomKDPoints(600, 5)
+	tree := NewKDTree(points[:200]...)
+	for _, p := range points[200:] {
+		tree.Insert(p)
+	}
+	if tree.Len() != len(points) || !IndexSetsEqual(kdIndices(points), kdIndices(tree.Points())) {
+		t.Fatalf("Insert failed. Points were not equal")
+	}
+
+	// Delete every third point, in an order unrelated to the tree
+	remaining := []KDPoint{}
+	for i, p := range points {
+		if i%3 != 0 {
+			remaining = append(remaining, p)
+			continue
+		}
+		if !tree.Delete(p) {
+			t.Fatalf("Delete %v failed. Point %v was not found", i, p)
+		}
+	}
+	if tree.Delete(points[0]) {
+		t.Fatalf("Delete failed. Deleted point was found again")
+	}
+	if tree.Delete(KDPoint{Location: points[1].Location, Payload: -1}) {
+		t.Fatalf("Delete failed. Point with a different payload was deleted")
+	}
+	if tree.Len() != len(remaining) || !IndexSetsEqual(kdIndices(remaining), kdIndices(tree.Points())) {
+		t.Fatalf("Delete failed. Points were not equal")
+	}
+
+	check := func(name string) {
+		for i, q := range kdQueries {
+			expected := kdWithin(remaining, q, 25)
+			if actual := kdIndices(tree.Within(q, 25)); !IndexSetsEqual(expected, actual) {
+				t.Fatalf("%v %v failed. Within found:\n\tExpected: %v,\n\tActual: %v", name, i, expected, actual)
+			}
+			nearest, _ := tree.Nearest(q)
+			if !near(distanceSquared(nearest.Location, q), distanceSquared(linearNearestK(remaining, q, 1)[0].Location, q)) {
+				t.Fatalf("%v %v failed. Nearest found %v", name, i, nearest)
+			}
+		}
+	}
+	check("Delete")
+	tree.Rebuild()
+	check("Rebuild")
+
+	for _, p := range remaining {
+		tree.Delete(p)
+	}
+	if tree.Len() != 0 || len(tree.Points()) != 0 {
+		t.Fatalf("Delete failed. Tree was not empty")
+	}
+	tree.Insert(points[0])
+	if nearest, ok := tree.Nearest(Cartesian{}); !ok || nearest != points[0] {
+		t.Fatalf("Insert failed. Tree did not hold %v", points[0])
+	}
+}
+
+// KDPointsDeepEqual compares sets of KDPoints with reflect.DeepEqual, so payloads can be uncomparable
+func KDPointsDeepEqual(a, b []KDPoint) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	used := make([]bool, len(b))
+	for _, p := range a {
+		found := false
+		for j, q := range b {
+			if !used[j] && reflect.DeepEqual(p, q) {
+				used[j], found = true, true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func TestKDTreeDeleteUncomparable(t *testing.T) {
+	type holder struct {
+		X interface{}
+	}
+	location := Cartesian{1, 2, 3}
+	uncomparable := []KDPoint{
+		{Location: location, Payload: []int{1}},
+		{Location: location, Payload: map[string]int{"a": 1}},
+		{Location: location, Payload: holder{[]int{2}}},
+	}
+	comparable := []KDPoint{
+		{Location: location, Payload: 7},
+		{Location: location, Payload: nil},
+		{Location: location, Payload: holder{3}},
+	}
+	tree := NewKDTree(append(append([]KDPoint{}, uncomparable...), comparable...)...)
+	for i, p := range uncomparable {
+		if tree.Delete(p) {
+			t.Fatalf("Delete %v failed. Point with an uncomparable payload was deleted", i)
+		}
+	}
+	for i, p := range comparable {
+		if !tree.Delete(p) {
+			t.Fatalf("Delete %v failed. Point %v was not found", i, p)
+		}
+	}
+	if tree.Len() != len(uncomparable) || !KDPointsDeepEqual(uncomparable, tree.Points()) {
+		t.Fatalf("Delete failed. Points were not equal:\n\tExpected: %v,\n\tActual: %v", uncomparable, tree.Points())
+	}
+
+	// A point with an uncomparable payload which replaces a deleted point is moved, not copied
+	tree = NewKDTree(
+		KDPoint{Location: Cartesian{0, 0, 0}, Payload: 0},
+		KDPoint{Location: Cartesian{1, 0, 0}, Payload: 1},
+		KDPoint{Location: Cartesian{2, 0, 0}, Payload: []int{1}},
+	)
+	if !tree.Delete(KDPoint{Location: Cartesian{1, 0, 0}, Payload: 1}) {
+		t.Fatalf("Delete failed. Point was not found")
+	}
+	expected := []KDPoint{
+		{Location: Cartesian{0, 0, 0}, Payload: 0},
+		{Location: Cartesian{2, 0, 0}, Payload: []int{1}},
+	}
+	if tree.Len() != len(expected) || !KDPointsDeepEqual(expected, tree.Points()) {
+		t.Fatalf("Delete failed. Points were not equal:\n\tExpected: %v,\n\tActual: %v", expected, tree.Points())
+	}
+}
+
+var benchmarkKDPoints []KDPoint
+var benchmarkIndices []int
+
+func BenchmarkKDTreeNearest(b *testing.B) {
+	tree := NewKDTree(randomKDPoints(10000, 6)...)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchmarkKDPoints = tree.NearestK(kdQueries[i%len(kdQueries)], 1)
+	}
+}
+
+func BenchmarkLinearNearest(b *testing.B) {
+	points := randomKDPoints(10000, 6)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		q := kdQueries[i%len(kdQueries)]
+		best := points[0]
+		for _, p := range points[1:] {
+			if distanceSquared(p.Location, q) < distanceSquared(best.Location, q) {
+				best = p
+			}
+		}
+		benchmarkKDPoints = []KDPoint{best}
+	}
+}
+
+func BenchmarkKDTreeWithin(b *testing.B) {
+	tree := NewKDTree(randomKDPoints(10000, 7)...)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchmarkKDPoints = tree.Within(kdQueries[i%len(kdQueries)], 10)
+	}
+}
+
+func BenchmarkLinearWithin(b *testing.B) {
+	locations := kdLocations(randomKDPoints(10000, 7))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchmarkIndices = linearWithin(locations, kdQueries[i%len(kdQueries)], 10)
+	}
+}
+
+func BenchmarkNewKDTree(b *testing.B) {
+	points := randomKDPoints(10000, 8)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		NewKDTree(points...)
+	}
+}
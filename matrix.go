@@ -2,8 +2,9 @@ package space
 
 import "math"
 
-// Matrix is a transformational matrix for 3D space (3 x 3)
-type Matrix [][]float64
+// Matrix is a transformational matrix for 3D space (4 x 4, homogeneous)
+// Matrix is a value type, so it can be copied and compared without allocating
+type Matrix [4][4]float64
 
 // NewRotationMatrixX produces a matrix which will rotate about X
 func NewRotationMatrixX(theta float64) Matrix {
@@ -38,14 +39,45 @@ func NewRotationMatrixZ(theta float64) Matrix {
 	}
 }
 
-// Multiply will return the result of m * n
-func (m Matrix) Multiply(n Matrix) Matrix {
-	r := Matrix{
-		{0, 0, 0, 0},
-		{0, 0, 0, 0},
-		{0, 0, 0, 0},
+// NewScaleMatrix produces a matrix which will scale along X, Y and Z
+func NewScaleMatrix(sx, sy, sz float64) Matrix {
+	return Matrix{
+		{sx, 0, 0, 0},
+		{0, sy, 0, 0},
+		{0, 0, sz, 0},
 		{0, 0, 0, 1},
 	}
+}
+
+// NewShearMatrix produces a matrix which will shear each axis along the others
+func NewShearMatrix(xy, xz, yx, yz, zx, zy float64) Matrix {
+	// Each factor moves its first axis along its second, so xy shifts X by xy for every unit of Y
+	return Matrix{
+		{1, xy, xz, 0},
+		{yx, 1, yz, 0},
+		{zx, zy, 1, 0},
+		{0, 0, 0, 1},
+	}
+}
+
+// NewReflectionMatrixXY produces a matrix which will reflect across the XY plane
+func NewReflectionMatrixXY() Matrix {
+	return NewScaleMatrix(1, 1, -1)
+}
+
+// NewReflectionMatrixXZ produces a matrix which will reflect across the XZ plane
+func NewReflectionMatrixXZ() Matrix {
+	return NewScaleMatrix(1, -1, 1)
+}
+
+// NewReflectionMatrixYZ produces a matrix which will reflect across the YZ plane
+func NewReflectionMatrixYZ() Matrix {
+	return NewScaleMatrix(-1, 1, 1)
+}
+
+// Multiply will return the result of m * n
+func (m Matrix) Multiply(n Matrix) Matrix {
+	var r Matrix
 	for rowM := 0; rowM < 4; rowM++ {
 		for colN := 0; colN < 4; colN++ {
 			a := m[rowM][0] * n[0][colN]
@@ -57,3 +89,172 @@ func (m Matrix) Multiply(n Matrix) Matrix {
 	}
 	return r
 }
+
+// Apply will return c transformed by m
+func (m Matrix) Apply(c Cartesian) Cartesian {
+	w := (c.X * m[3][0]) + (c.Y * m[3][1]) + (c.Z * m[3][2]) + (1 * m[3][3])
+	return Cartesian{
+		X: ((c.X * m[0][0]) + (c.Y * m[0][1]) + (c.Z * m[0][2]) + (1 * m[0][3])) / w,
+		Y: ((c.X * m[1][0]) + (c.Y * m[1][1]) + (c.Z * m[1][2]) + (1 * m[1][3])) / w,
+		Z: ((c.X * m[2][0]) + (c.Y * m[2][1]) + (c.Z * m[2][2]) + (1 * m[2][3])) / w,
+	}
+}
+
+// NewIdentityMatrix produces a matrix which will not transform
+func NewIdentityMatrix() Matrix {
+	return Matrix{
+		{1, 0, 0, 0},
+		{0, 1, 0, 0},
+		{0, 0, 1, 0},
+		{0, 0, 0, 1},
+	}
+}
+
+// Transpose will return m with its rows and columns swapped
+func (m Matrix) Transpose() Matrix {
+	return Matrix{
+		{m[0][0], m[1][0], m[2][0], m[3][0]},
+		{m[0][1], m[1][1], m[2][1], m[3][1]},
+		{m[0][2], m[1][2], m[2][2], m[3][2]},
+		{m[0][3], m[1][3], m[2][3], m[3][3]},
+	}
+}
+
+// Determinant will return the determinant of m
+func (m Matrix) Determinant() float64 {
+	s, c := m.minors()
+	return (s[0] * c[5]) - (s[1] * c[4]) + (s[2] * c[3]) + (s[3] * c[2]) - (s[4] * c[1]) + (s[5] * c[0])
+}
+
+// Inverse will return the matrix which undoes m
+// If m is singular (it collapses space and can't be undone), ok will be false
+func (m Matrix) Inverse() (inverse Matrix, ok bool) {
+	s, c := m.minors()
+	det := (s[0] * c[5]) - (s[1] * c[4]) + (s[2] * c[3]) + (s[3] * c[2]) - (s[4] * c[1]) + (s[5] * c[0])
+
+	// Compare the determinant against the largest it could be for rows of these lengths (Hadamard's inequality)
+	// so that matricies which are merely small are not mistaken for singular ones
+	bound := 1.0
+	for row := 0; row < 4; row++ {
+		bound *= math.Sqrt((m[row][0] * m[row][0]) + (m[row][1] * m[row][1]) + (m[row][2] * m[row][2]) + (m[row][3] * m[row][3]))
+	}
+	if bound == 0 || near(det/bound, 0) {
+		return NewIdentityMatrix(), false
+	}
+
+	i := 1 / det
+	return Matrix{
+		{
+			((m[1][1] * c[5]) - (m[1][2] * c[4]) + (m[1][3] * c[3])) * i,
+			(-(m[0][1] * c[5]) + (m[0][2] * c[4]) - (m[0][3] * c[3])) * i,
+			((m[3][1] * s[5]) - (m[3][2] * s[4]) + (m[3][3] * s[3])) * i,
+			(-(m[2][1] * s[5]) + (m[2][2] * s[4]) - (m[2][3] * s[3])) * i,
+		},
+		{
+			(-(m[1][0] * c[5]) + (m[1][2] * c[2]) - (m[1][3] * c[1])) * i,
+			((m[0][0] * c[5]) - (m[0][2] * c[2]) + (m[0][3] * c[1])) * i,
+			(-(m[3][0] * s[5]) + (m[3][2] * s[2]) - (m[3][3] * s[1])) * i,
+			((m[2][0] * s[5]) - (m[2][2] * s[2]) + (m[2][3] * s[1])) * i,
+		},
+		{
+			((m[1][0] * c[4]) - (m[1][1] * c[2]) + (m[1][3] * c[0])) * i,
+			(-(m[0][0] * c[4]) + (m[0][1] * c[2]) - (m[0][3] * c[0])) * i,
+			((m[3][0] * s[4]) - (m[3][1] * s[2]) + (m[3][3] * s[0])) * i,
+			(-(m[2][0] * s[4]) + (m[2][1] * s[2]) - (m[2][3] * s[0])) * i,
+		},
+		{
+			(-(m[1][0] * c[3]) + (m[1][1] * c[1]) - (m[1][2] * c[0])) * i,
+			((m[0][0] * c[3]) - (m[0][1] * c[1]) + (m[0][2] * c[0])) * i,
+			(-(m[3][0] * s[3]) + (m[3][1] * s[1]) - (m[3][2] * s[0])) * i,
+			((m[2][0] * s[3]) - (m[2][1] * s[1]) + (m[2][2] * s[0])) * i,
+		},
+	}, true
+}
+
+// RigidInverse will return the matrix which undoes m, which must only rotate and translate
+func (m Matrix) RigidInverse() Matrix {
+	// Without scale or shear no determinant is needed. The inverse of rotation R then translation t is R^T then -R^T * t
+	return Matrix{
+		{m[0][0], m[1][0], m[2][0], -((m[0][0] * m[0][3]) + (m[1][0] * m[1][3]) + (m[2][0] * m[2][3]))},
+		{m[0][1], m[1][1], m[2][1], -((m[0][1] * m[0][3]) + (m[1][1] * m[1][3]) + (m[2][1] * m[2][3]))},
+		{m[0][2], m[1][2], m[2][2], -((m[0][2] * m[0][3]) + (m[1][2] * m[1][3]) + (m[2][2] * m[2][3]))},
+		{0, 0, 0, 1},
+	}
+}
+
+// minors returns the 2x2 determinants of the top two rows (s) and bottom two rows (c)
+func (m Matrix) minors() (s, c [6]float64) {
+	s = [6]float64{
+		(m[0][0] * m[1][1]) - (m[1][0] * m[0][1]),
+		(m[0][0] * m[1][2]) - (m[1][0] * m[0][2]),
+		(m[0][0] * m[1][3]) - (m[1][0] * m[0][3]),
+		(m[0][1] * m[1][2]) - (m[1][1] * m[0][2]),
+		(m[0][1] * m[1][3]) - (m[1][1] * m[0][3]),
+		(m[0][2] * m[1][3]) - (m[1][2] * m[0][3]),
+	}
+	c = [6]float64{
+		(m[2][0] * m[3][1]) - (m[3][0] * m[2][1]),
+		(m[2][0] * m[3][2]) - (m[3][0] * m[2][2]),
+		(m[2][0] * m[3][3]) - (m[3][0] * m[2][3]),
+		(m[2][1] * m[3][2]) - (m[3][1] * m[2][2]),
+		(m[2][1] * m[3][3]) - (m[3][1] * m[2][3]),
+		(m[2][2] * m[3][3]) - (m[3][2] * m[2][3]),
+	}
+	return s, c
+}
+
+// NewAxisAngleMatrix produces a matrix which will rotate by angle about axis
+// If axis has no length, the identity matrix is returned
+func NewAxisAngleMatrix(axis Vector, angle float64) Matrix {
+	a := axis.Cartesian()
+	length := a.Length()
+	if length == 0 {
+		return NewIdentityMatrix()
+	}
+	k := Cartesian{a.X / length, a.Y / length, a.Z / length}
+	// Rodrigues' rotation formula
+	sin, cos := math.Sincos(angle)
+	c := 1 - cos
+	return Matrix{
+		{cos + k.X*k.X*c, k.X*k.Y*c - k.Z*sin, k.X*k.Z*c + k.Y*sin, 0},
+		{k.Y*k.X*c + k.Z*sin, cos + k.Y*k.Y*c, k.Y*k.Z*c - k.X*sin, 0},
+		{k.Z*k.X*c - k.Y*sin, k.Z*k.Y*c + k.X*sin, cos + k.Z*k.Z*c, 0},
+		{0, 0, 0, 1},
+	}
+}
+
+// NewRotationBetween produces a matrix which will rotate the direction of from onto the direction of to
+// The rotation is the smallest which does so. If from and to are opposite, the half turn is about
+// an arbitrary axis which is orthogonal to from.
+func NewRotationBetween(from, to Vector) Matrix {
+	axis := from.Cross(to)
+	// The cross product is short compared to from and to when they are parallel, whatever their lengths
+	if axis.Length() > MinErr*from.Length()*to.Length() {
+		return NewAxisAngleMatrix(axis, from.AngleTo(to))
+	}
+	if from.Dot(to) >= 0 {
+		return NewIdentityMatrix()
+	}
+	return NewAxisAngleMatrix(orthogonalAxis(from), math.Pi)
+}
+
+// orthogonalAxis returns an arbitrary Vector which is orthogonal to v
+func orthogonalAxis(v Vector) Vector {
+	axis := v.Cross(Cartesian{1, 0, 0})
+	if axis.Length() <= MinErr*v.Length() {
+		axis = v.Cross(Cartesian{0, 1, 0})
+	}
+	return axis
+}
+
+// NewLookAtMatrix produces a matrix which will transform from the local space of an object at eye, looking at target, into world space
+func NewLookAtMatrix(eye, target, up Vector) Matrix {
+	e := eye.Cartesian()
+	forward := target.Subtract(e)
+	if near(forward.Length(), 0) {
+		// With target at eye there is nothing to look at, so only translate
+		return e.TranslationMatrix()
+	}
+	// Like Object.Matrix, local Z is turned towards target and local Y towards the part of up orthogonal to it
+	return NewObject(e, forward.Spherical(), up.Spherical()).Matrix()
+}
@@ -648,3 +648,539 @@ func TestMatrixMultiply(t *testing.T) {
 		}
 	}
 }
+
+func TestMatrixTranspose(t *testing.T) {
+	cases := []struct {
+		M        Matrix
+		Expected Matrix
+	}{
+		{
+			M:        NewIdentityMatrix(),
+			Expected: NewIdentityMatrix(),
+		},
+		{
+			M: Matrix{
+				{5, 7, 9, 10},
+				{2, 3, 3, 8},
+				{8, 10, 2, 3},
+				{3, 3, 4, 8},
+			},
+			Expected: Matrix{
+				{5, 2, 8, 3},
+				{7, 3, 10, 3},
+				{9, 3, 2, 4},
+				{10, 8, 3, 8},
+			},
+		},
+	}
+	for i, c := range cases {
+		actual := c.M.Transpose()
+		if !MatriciesEqual(c.Expected, actual) {
+			t.Fatalf("Transpose %v failed. Matricies were not equal:\n\tExpected: %v,\n\tActual: %v", i, c.Expected, actual)
+		}
+	}
+}
+
+func TestMatrixDeterminant(t *testing.T) {
+	cases := []struct {
+		M        Matrix
+		Expected float64
+	}{
+		{
+			M:        NewIdentityMatrix(),
+			Expected: 1,
+		},
+		{
+			M:        NewRotationMatrixX(rad(1, 3)).Multiply(Cartesian{2, 3, 5}.TranslationMatrix()),
+			Expected: 1,
+		},
+		{
+			M: Matrix{
+				{2, 0, 0, 0},
+				{0, 3, 0, 0},
+				{0, 0, 5, 0},
+				{0, 0, 0, 1},
+			},
+			Expected: 30,
+		},
+		{
+			M: Matrix{
+				{5, 7, 9, 10},
+				{2, 3, 3, 8},
+				{8, 10, 2, 3},
+				{3, 3, 4, 8},
+			},
+			Expected: -361,
+		},
+		{
+			M: Matrix{
+				{1, 2, 3, 4},
+				{2, 4, 6, 8},
+				{8, 10, 2, 3},
+				{3, 3, 4, 8},
+			},
+			Expected: 0,
+		},
+	}
+	for i, c := range cases {
+		actual := c.M.Determinant()
+		if !near(c.Expected, actual) {
+			t.Fatalf("Determinant %v failed:\n\tExpected: %v,\n\tActual: %v", i, c.Expected, actual)
+		}
+	}
+}
+
+func TestMatrixInverse(t *testing.T) {
+	cases := []struct {
+		M  Matrix
+		OK bool
+	}{
+		{
+			M:  NewIdentityMatrix(),
+			OK: true,
+		},
+		{
+			M:  NewRotationMatrixZ(rad(2, 3)).Multiply(Cartesian{-2, 3, 5}.TranslationMatrix()),
+			OK: true,
+		},
+		{
+			M: Matrix{
+				{0.001, 0, 0, 0},
+				{0, 0.001, 0, 0},
+				{0, 0, 0.001, 0},
+				{0, 0, 0, 1},
+			},
+			OK: true,
+		},
+		{
+			M: Matrix{
+				{5, 7, 9, 10},
+				{2, 3, 3, 8},
+				{8, 10, 2, 3},
+				{3, 3, 4, 8},
+			},
+			OK: true,
+		},
+		{
+			M: Matrix{
+				{1, 2, 3, 4},
+				{2, 4, 6, 8},
+				{8, 10, 2, 3},
+				{3, 3, 4, 8},
+			},
+			OK: false,
+		},
+		{
+			M: Matrix{
+				{1, 0, 0, 0},
+				{0, 1, 0, 0},
+				{0, 0, 0, 0},
+				{0, 0, 0, 1},
+			},
+			OK: false,
+		},
+	}
+	for i, c := range cases {
+		inverse, ok := c.M.Inverse()
+		if ok != c.OK {
+			t.Fatalf("Inverse %v failed:\n\tExpected ok: %v,\n\tActual ok: %v", i, c.OK, ok)
+		}
+		if !ok {
+			continue
+		}
+		if actual := c.M.Multiply(inverse); !MatriciesEqual(NewIdentityMatrix(), actual) {
+			t.Fatalf("Inverse %v failed. M * Inverse was not identity:\n\tActual: %v", i, actual)
+		}
+		if actual := inverse.Multiply(c.M); !MatriciesEqual(NewIdentityMatrix(), actual) {
+			t.Fatalf("Inverse %v failed. Inverse * M was not identity:\n\tActual: %v", i, actual)
+		}
+	}
+}
+
+func TestMatrixRigidInverse(t *testing.T) {
+	cases := []Matrix{
+		NewIdentityMatrix(),
+		Cartesian{2, 3, 5}.TranslationMatrix(),
+		OctantNXYZ.Spherical.RotationMatrix(),
+		Cartesian{2, 3, 5}.TranslationMatrix().Multiply(OctantXNYNZ3.Spherical.RotationMatrix()),
+		NewRotationMatrixY(rad(1, 5)).Multiply(Cartesian{-7, 1, 0}.TranslationMatrix()).Multiply(NewRotationMatrixX(rad(3, 4))),
+	}
+	for i, m := range cases {
+		expected, _ := m.Inverse()
+		actual := m.RigidInverse()
+		if !MatriciesEqual(expected, actual) {
+			t.Fatalf("RigidInverse %v failed. Matricies were not equal:\n\tExpected: %v,\n\tActual: %v", i, expected, actual)
+		}
+	}
+}
+
+func TestMatrixApply(t *testing.T) {
+	cases := []CartesianTest{
+		{
+			Initial: Cartesian{2, 3, 5},
+			Operation: func(v Cartesian) Cartesian {
+				return NewIdentityMatrix().Apply(v)
+			},
+			Expected: Cartesian{2, 3, 5},
+		},
+		{
+			Initial: Cartesian{2, 3, 5},
+			Operation: func(v Cartesian) Cartesian {
+				m := NewRotationMatrixZ(rad(1, 2)).Multiply(Cartesian{1, 1, 1}.TranslationMatrix())
+				return m.Apply(v)
+			},
+			Expected: Cartesian{-4, 3, 6},
+		},
+		{
+			Initial: Cartesian{2, 4, 6},
+			Operation: func(v Cartesian) Cartesian {
+				m := Matrix{
+					{1, 0, 0, 0},
+					{0, 1, 0, 0},
+					{0, 0, 1, 0},
+					{0, 0, 0, 2},
+				}
+				return m.Apply(v)
+			},
+			Expected: Cartesian{1, 2, 3},
+		},
+	}
+	RunCartesianTests(t, cases)
+}
+
+func TestMatrixAllocations(t *testing.T) {
+	m := NewRotationMatrixX(rad(1, 3)).Multiply(Cartesian{2, 3, 5}.TranslationMatrix())
+	c := Cartesian{7, 8, 9}
+	cases := []struct {
+		Name      string
+		Operation func()
+	}{
+		{
+			Name:      "NewRotationMatrixX",
+			Operation: func() { m = NewRotationMatrixX(rad(1, 3)) },
+		},
+		{
+			Name:      "Multiply",
+			Operation: func() { m = m.Multiply(m) },
+		},
+		{
+			Name:      "Apply",
+			Operation: func() { c = m.Apply(c) },
+		},
+		{
+			Name:      "Transform",
+			Operation: func() { c = c.Transform(m).Cartesian() },
+		},
+		{
+			Name:      "Inverse",
+			Operation: func() { m, _ = m.Inverse() },
+		},
+	}
+	for _, tc := range cases {
+		if allocs := testing.AllocsPerRun(100, tc.Operation); allocs != 0 {
+			t.Fatalf("%v allocated %v times, expected 0", tc.Name, allocs)
+		}
+	}
+}
+
+var benchmarkMatrix Matrix
+var benchmarkCartesian Cartesian
+
+func BenchmarkNewRotationMatrixX(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchmarkMatrix = NewRotationMatrixX(float64(i))
+	}
+}
+
+func BenchmarkMatrixMultiply(b *testing.B) {
+	m := NewRotationMatrixX(rad(1, 3))
+	n := Cartesian{2, 3, 5}.TranslationMatrix()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchmarkMatrix = m.Multiply(n)
+	}
+}
+
+func BenchmarkMatrixInverse(b *testing.B) {
+	m := NewRotationMatrixX(rad(1, 3)).Multiply(Cartesian{2, 3, 5}.TranslationMatrix())
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchmarkMatrix, _ = m.Inverse()
+	}
+}
+
+func BenchmarkMatrixApply(b *testing.B) {
+	m := NewRotationMatrixX(rad(1, 3)).Multiply(Cartesian{2, 3, 5}.TranslationMatrix())
+	c := Cartesian{7, 8, 9}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchmarkCartesian = m.Apply(c)
+	}
+}
+
+func BenchmarkCartesianTransform(b *testing.B) {
+	m := NewRotationMatrixX(rad(1, 3)).Multiply(Cartesian{2, 3, 5}.TranslationMatrix())
+	c := Cartesian{7, 8, 9}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchmarkCartesian = c.Transform(m).Cartesian()
+	}
+}
+
+func TestNewAxisAngleMatrix(t *testing.T) {
+	cases := []struct {
+		M        Matrix
+		Expected Matrix
+	}{
+		{
+			M:        NewAxisAngleMatrix(AxisX3.Cartesian, rad(1, 3)),
+			Expected: NewRotationMatrixX(rad(1, 3)),
+		},
+		{
+			M:        NewAxisAngleMatrix(AxisY.Spherical, rad(-3, 4)),
+			Expected: NewRotationMatrixY(rad(-3, 4)),
+		},
+		{
+			M:        NewAxisAngleMatrix(AxisZN.Cartesian, rad(1, 2)),
+			Expected: NewRotationMatrixZ(rad(-1, 2)),
+		},
+		{
+			M:        NewAxisAngleMatrix(OctantNXYZ3.Spherical, rad(4, 5)),
+			Expected: NewAxisAngleQuaternion(OctantNXYZ.Cartesian, rad(4, 5)).RotationMatrix(),
+		},
+		{
+			M:        NewAxisAngleMatrix(Origin.Cartesian, rad(4, 5)),
+			Expected: NewIdentityMatrix(),
+		},
+		{
+			// A short axis still has a direction
+			M:        NewAxisAngleMatrix(Cartesian{0, 1e-7, 0}, rad(1, 3)),
+			Expected: NewRotationMatrixY(rad(1, 3)),
+		},
+	}
+	for i, c := range cases {
+		if !MatriciesEqual(c.Expected, c.M) {
+			t.Fatalf("NewAxisAngleMatrix %v failed. Matricies were not equal:\n\tExpected: %v,\n\tActual: %v", i, c.Expected, c.M)
+		}
+	}
+}
+
+func TestNewRotationBetween(t *testing.T) {
+	directions := []vectorEquivalency{
+		AxisX,
+		AxisXN3,
+		AxisY,
+		AxisZ3,
+		AxisZN,
+		OctantXYZ,
+		OctantNXNYNZ3,
+		OctantXNYZ,
+	}
+	cases := []CartesianTest{}
+	for _, from := range directions {
+		for _, to := range directions {
+			f, d := from.Spherical, to.Cartesian
+			cases = append(cases, CartesianTest{
+				Initial: from.Cartesian,
+				Operation: func(v Cartesian) Cartesian {
+					m := NewRotationBetween(f, d)
+					return v.Transform(m).Normalize().Cartesian()
+				},
+				Expected: to.Cartesian.Normalize().Cartesian(),
+			})
+		}
+	}
+	// The rotation is the smallest, so the axis it turns about is not moved
+	cases = append(cases, CartesianTest{
+		Initial: AxisZ.Cartesian,
+		Operation: func(v Cartesian) Cartesian {
+			m := NewRotationBetween(AxisX.Cartesian, AxisY3.Cartesian)
+			return v.Transform(m).Cartesian()
+		},
+		Expected: AxisZ.Cartesian,
+	})
+	// Short vectors turn the same as long ones
+	cases = append(cases, CartesianTest{
+		Initial: AxisX.Cartesian,
+		Operation: func(v Cartesian) Cartesian {
+			m := NewRotationBetween(Cartesian{1e-4, 0, 0}, Cartesian{0, 1e-4, 0})
+			return v.Transform(m).Cartesian()
+		},
+		Expected: AxisY.Cartesian,
+	}, CartesianTest{
+		Initial: AxisX.Cartesian,
+		Operation: func(v Cartesian) Cartesian {
+			m := NewRotationBetween(Cartesian{1e-4, 0, 0}, Cartesian{-1e-4, 0, 0})
+			return v.Transform(m).Cartesian()
+		},
+		Expected: AxisXN.Cartesian,
+	})
+	RunCartesianTests(t, cases)
+}
+
+func TestNewLookAtMatrix(t *testing.T) {
+	cases := []struct {
+		Eye, Target, Up Vector
+		Local           Cartesian
+		Expected        Cartesian
+	}{
+		{
+			Eye:      Origin.Cartesian,
+			Target:   AxisZ3.Cartesian,
+			Up:       AxisY.Cartesian,
+			Local:    Cartesian{2, 3, 5},
+			Expected: Cartesian{2, 3, 5},
+		},
+		{
+			Eye:      Cartesian{1, 1, 1},
+			Target:   Cartesian{5, 1, 1},
+			Up:       AxisZ.Spherical,
+			Local:    AxisZ.Cartesian,
+			Expected: Cartesian{2, 1, 1},
+		},
+		{
+			Eye:      Cartesian{1, 1, 1},
+			Target:   Cartesian{5, 1, 1},
+			Up:       AxisZ.Spherical,
+			Local:    AxisY.Cartesian,
+			Expected: Cartesian{1, 1, 2},
+		},
+		{
+			// up is orthogonalized against the direction to target
+			Eye:      Cartesian{1, 1, 1},
+			Target:   Cartesian{5, 1, 1},
+			Up:       Cartesian{3, 0, 1},
+			Local:    AxisY.Cartesian,
+			Expected: Cartesian{1, 1, 2},
+		},
+		{
+			// Looking straight down
+			Eye:      Cartesian{0, 0, 10},
+			Target:   Origin.Cartesian,
+			Up:       AxisX.Cartesian,
+			Local:    Cartesian{0, 2, 3},
+			Expected: Cartesian{2, 0, 7},
+		},
+		{
+			Eye:      Cartesian{2, 3, 5},
+			Target:   Cartesian{2, 3, 5},
+			Up:       AxisX.Cartesian,
+			Local:    AxisZ.Cartesian,
+			Expected: Cartesian{2, 3, 6},
+		},
+	}
+	for i, c := range cases {
+		m := NewLookAtMatrix(c.Eye, c.Target, c.Up)
+		if actual := c.Local.Transform(m).Cartesian(); !CartesiansEqual(c.Expected, actual) {
+			t.Fatalf("NewLookAtMatrix %v failed. Cartesians were not equal:\n\tExpected: %v,\n\tActual: %v", i, c.Expected, actual)
+		}
+	}
+}
+
+func TestNewScaleMatrix(t *testing.T) {
+	cases := []CartesianTest{
+		{
+			Initial: Cartesian{2, 3, 5},
+			Operation: func(v Cartesian) Cartesian {
+				return v.Transform(NewScaleMatrix(1, 1, 1)).Cartesian()
+			},
+			Expected: Cartesian{2, 3, 5},
+		},
+		{
+			Initial: Cartesian{2, 3, 5},
+			Operation: func(v Cartesian) Cartesian {
+				return v.Transform(NewScaleMatrix(2, -1, 0.5)).Cartesian()
+			},
+			Expected: Cartesian{4, -3, 2.5},
+		},
+		{
+			Initial: OctantXYZ3.Cartesian,
+			Operation: func(v Cartesian) Cartesian {
+				return v.Transform(NewScaleMatrix(3, 3, 3)).Cartesian()
+			},
+			Expected: OctantXYZ3.Cartesian.Scale(3).Cartesian(),
+		},
+	}
+	RunCartesianTests(t, cases)
+}
+
+func TestNewShearMatrix(t *testing.T) {
+	cases := []CartesianTest{
+		{
+			Initial: Cartesian{2, 3, 5},
+			Operation: func(v Cartesian) Cartesian {
+				return v.Transform(NewShearMatrix(0, 0, 0, 0, 0, 0)).Cartesian()
+			},
+			Expected: Cartesian{2, 3, 5},
+		},
+		{
+			Initial: Cartesian{2, 3, 5},
+			Operation: func(v Cartesian) Cartesian {
+				return v.Transform(NewShearMatrix(1, 0, 0, 0, 0, 0)).Cartesian()
+			},
+			Expected: Cartesian{5, 3, 5},
+		},
+		{
+			Initial: Cartesian{2, 3, 5},
+			Operation: func(v Cartesian) Cartesian {
+				return v.Transform(NewShearMatrix(0, 0, 0, 0.5, -1, 0)).Cartesian()
+			},
+			Expected: Cartesian{2, 5.5, 3},
+		},
+		{
+			// Points on the XY plane aren't moved by shearing along Z
+			Initial: Cartesian{2, 3, 0},
+			Operation: func(v Cartesian) Cartesian {
+				return v.Transform(NewShearMatrix(0, 4, 0, 7, 0, 0)).Cartesian()
+			},
+			Expected: Cartesian{2, 3, 0},
+		},
+	}
+	RunCartesianTests(t, cases)
+}
+
+func TestNewReflectionMatrix(t *testing.T) {
+	cases := []CartesianTest{
+		{
+			Initial: Cartesian{2, 3, 5},
+			Operation: func(v Cartesian) Cartesian {
+				return v.Transform(NewReflectionMatrixXY()).Cartesian()
+			},
+			Expected: Cartesian{2, 3, -5},
+		},
+		{
+			Initial: Cartesian{2, 3, 5},
+			Operation: func(v Cartesian) Cartesian {
+				return v.Transform(NewReflectionMatrixXZ()).Cartesian()
+			},
+			Expected: Cartesian{2, -3, 5},
+		},
+		{
+			Initial: Cartesian{2, 3, 5},
+			Operation: func(v Cartesian) Cartesian {
+				return v.Transform(NewReflectionMatrixYZ()).Cartesian()
+			},
+			Expected: Cartesian{-2, 3, 5},
+		},
+	}
+	RunCartesianTests(t, cases)
+
+	planes := []struct {
+		Matrix Matrix
+		Plane  Plane
+	}{
+		{NewReflectionMatrixXY(), NewPlane(AxisZ.Cartesian, 0)},
+		{NewReflectionMatrixXZ(), NewPlane(AxisY.Cartesian, 0)},
+		{NewReflectionMatrixYZ(), NewPlane(AxisX.Cartesian, 0)},
+	}
+	for i, p := range planes {
+		if expected := p.Plane.ReflectionMatrix(); !MatriciesEqual(expected, p.Matrix) {
+			t.Fatalf("NewReflectionMatrix %v failed. Matricies were not equal:\n\tExpected: %v,\n\tActual: %v", i, expected, p.Matrix)
+		}
+	}
+}
@@ -0,0 +1,309 @@
+package space
+
+import (
+	"fmt"
+	"math"
+)
+
+// Mesh is a surface made of triangles which share vertices
+type Mesh struct {
+	Vertices []Cartesian
+	// Faces are the indices of the vertices at the corners of each triangle
+	// Corners are counter-clockwise when seen from outside, so that normals face out.
+	Faces [][3]int
+}
+
+// NewMesh creates a new Mesh
+func NewMesh(vertices []Cartesian, faces [][3]int) Mesh {
+	return Mesh{
+		Vertices: vertices,
+		Faces:    faces,
+	}
+}
+
+// Triangle returns face i of m as a Triangle
+func (m Mesh) Triangle(i int) Triangle {
+	f := m.Faces[i]
+	return Triangle{
+		A: m.Vertices[f[0]],
+		B: m.Vertices[f[1]],
+		C: m.Vertices[f[2]],
+	}
+}
+
+// Triangles returns every face of m as a Triangle, in the same order as the faces
+// They can be passed to NewBVH, whose hits then give the index of the face.
+func (m Mesh) Triangles() []Triangle {
+	triangles := make([]Triangle, len(m.Faces))
+	for i := range m.Faces {
+		triangles[i] = m.Triangle(i)
+	}
+	return triangles
+}
+
+// FaceNormals returns the normal (of length one) of each face of m
+// Faces with no area have normals with no length.
+func (m Mesh) FaceNormals() []Cartesian {
+	normals := make([]Cartesian, len(m.Faces))
+	for i := range m.Faces {
+		normals[i] = m.Triangle(i).Normal()
+	}
+	return normals
+}
+
+// VertexNormals returns the normal (of length one) of each vertex of m
+// Each is the average of the normals of the faces around the vertex, weighted by their area.
+// Vertices which aren't the corner of a face with area have normals with no length.
+func (m Mesh) VertexNormals() []Cartesian {
+	sums := make([]Cartesian, len(m.Vertices))
+	for i, f := range m.Faces {
+		t := m.Triangle(i)
+		// The cross product is twice the area of the face long, so it weights itself
+		n := t.B.Subtract(t.A).Cross(t.C.Subtract(t.A))
+		for _, v := range f {
+			sums[v] = sums[v].Translate(n).Cartesian()
+		}
+	}
+	for i, n := range sums {
+		if length := n.Length(); length > 0 {
+			sums[i] = Cartesian{n.X / length, n.Y / length, n.Z / length}
+		}
+	}
+	return sums
+}
+
+// Area returns the area of the surface of m
+func (m Mesh) Area() float64 {
+	area := 0.0
+	for i := range m.Faces {
+		area += m.Triangle(i).Area()
+	}
+	return area
+}
+
+// Volume returns the volume enclosed by m
+// The volume is negative if the faces of m are turned inside out.
+// It is only meaningful if m is closed, which Validate checks.
+func (m Mesh) Volume() float64 {
+	volume := 0.0
+	for i := range m.Faces {
+		t := m.Triangle(i)
+		// Each face forms a tetrahedron with the origin, which is negative for faces facing it
+		volume += t.A.Dot(t.B.Cross(t.C)) / 6
+	}
+	return volume
+}
+
+// Centroid returns the center of mass of the solid enclosed by m
+// If m encloses no volume, the center of its surface is returned,
+// and if it has no area either, the average of its vertices is returned.
+func (m Mesh) Centroid() Cartesian {
+	volume := 0.0
+	weighted := Cartesian{}
+	for i := range m.Faces {
+		t := m.Triangle(i)
+		v := t.A.Dot(t.B.Cross(t.C)) / 6
+		// The centroid of the tetrahedron with the origin is a quarter of the sum of the corners
+		corners := t.A.Translate(t.B).Translate(t.C)
+		weighted = weighted.Translate(corners.Scale(v / 4)).Cartesian()
+		volume += v
+	}
+	// Volumes and areas are compared to the size of m, so that small meshes are treated like large ones
+	size := m.Bounds().Size()
+	extent := math.Max(size.X, math.Max(size.Y, size.Z))
+	if math.Abs(volume) > MinErr*extent*extent*extent {
+		return weighted.Scale(1 / volume).Cartesian()
+	}
+
+	area := 0.0
+	weighted = Cartesian{}
+	for i := range m.Faces {
+		t := m.Triangle(i)
+		a := t.Area()
+		weighted = weighted.Translate(t.Centroid().Scale(a)).Cartesian()
+		area += a
+	}
+	if area > MinErr*extent*extent {
+		return weighted.Scale(1 / area).Cartesian()
+	}
+
+	if len(m.Vertices) == 0 {
+		return Cartesian{}
+	}
+	sum := Cartesian{}
+	for _, v := range m.Vertices {
+		sum = sum.Translate(v).Cartesian()
+	}
+	return sum.Scale(1 / float64(len(m.Vertices))).Cartesian()
+}
+
+// Bounds returns the smallest AABB which contains every vertex of m
+// If m has no vertices, an AABB around the origin with no size is returned
+func (m Mesh) Bounds() AABB {
+	if len(m.Vertices) == 0 {
+		return AABB{}
+	}
+	b := AABB{Min: m.Vertices[0], Max: m.Vertices[0]}
+	for _, v := range m.Vertices[1:] {
+		b = b.Expand(v)
+	}
+	return b
+}
+
+// Transform returns a copy of m with every vertex transformed by t
+// If t mirrors space, the corners of each face are reversed so that the normals still face out.
+func (m Mesh) Transform(t Matrix) Mesh {
+	vertices := make([]Cartesian, len(m.Vertices))
+	for i, v := range m.Vertices {
+		vertices[i] = t.Apply(v)
+	}
+	faces := make([][3]int, len(m.Faces))
+	copy(faces, m.Faces)
+	if t.Determinant() < 0 {
+		for i, f := range faces {
+			faces[i] = [3]int{f[0], f[2], f[1]}
+		}
+	}
+	return Mesh{
+		Vertices: vertices,
+		Faces:    faces,
+	}
+}
+
+// Weld returns a copy of m in which vertices within MinErr of each other are merged
+// Each merged vertex keeps the location of the first of its vertices.
+// Faces which lose their area because two of their corners were merged are removed.
+func (m Mesh) Weld() Mesh {
+	// Cells the size of MinErr put vertices which can be merged in neighboring cells
+	hash := NewSpatialHash(MinErr)
+	vertices := []Cartesian{}
+	remap := make([]int, len(m.Vertices))
+	for i, v := range m.Vertices {
+		merged := -1
+		hash.EachNeighbor(v, func(p HashPoint) {
+			if merged < 0 && distanceSquared(p.Location, v) <= MinErr*MinErr {
+				merged = p.Key.(int)
+			}
+		})
+		if merged < 0 {
+			merged = len(vertices)
+			vertices = append(vertices, v)
+			hash.Insert(merged, v)
+		}
+		remap[i] = merged
+	}
+
+	faces := make([][3]int, 0, len(m.Faces))
+	for _, f := range m.Faces {
+		welded := [3]int{remap[f[0]], remap[f[1]], remap[f[2]]}
+		if welded[0] == welded[1] || welded[1] == welded[2] || welded[2] == welded[0] {
+			continue
+		}
+		faces = append(faces, welded)
+	}
+	return Mesh{
+		Vertices: vertices,
+		Faces:    faces,
+	}
+}
+
+// meshEdge is an edge between two vertices of a Mesh, from the first to the second
+type meshEdge [2]int
+
+// edgeFaces returns the faces along each edge of m, ignoring its direction
+// Edges are keyed with the smaller vertex first.
+func (m Mesh) edgeFaces() map[meshEdge][]int {
+	edges := map[meshEdge][]int{}
+	for i, f := range m.Faces {
+		for j := range f {
+			a, b := f[j], f[(j+1)%3]
+			if a > b {
+				a, b = b, a
+			}
+			edges[meshEdge{a, b}] = append(edges[meshEdge{a, b}], i)
+		}
+	}
+	return edges
+}
+
+// Closed returns true if every edge of m is shared by exactly two faces, so that m encloses a volume
+func (m Mesh) Closed() bool {
+	for _, faces := range m.edgeFaces() {
+		if len(faces) != 2 {
+			return false
+		}
+	}
+	return true
+}
+
+// Validate returns an error describing the first problem which stops m from being a manifold surface
+// Every index must refer to a vertex, no face may use a vertex twice, every edge must be shared by
+// one or two faces which turn the same way, and the faces around each vertex must be connected.
+// Edges along a single face are allowed, so open surfaces are valid; Closed checks for these.
+func (m Mesh) Validate() error {
+	for i, f := range m.Faces {
+		for _, v := range f {
+			if v < 0 || v >= len(m.Vertices) {
+				return fmt.Errorf("face %d refers to vertex %d, but there are %d vertices", i, v, len(m.Vertices))
+			}
+		}
+		if f[0] == f[1] || f[1] == f[2] || f[2] == f[0] {
+			return fmt.Errorf("face %d uses a vertex more than once: %v", i, f)
+		}
+	}
+
+	directed := map[meshEdge]int{}
+	for i, f := range m.Faces {
+		for j := range f {
+			e := meshEdge{f[j], f[(j+1)%3]}
+			if other, ok := directed[e]; ok {
+				return fmt.Errorf("faces %d and %d both run from vertex %d to %d, so they turn opposite ways or overlap", other, i, e[0], e[1])
+			}
+			directed[e] = i
+		}
+	}
+	edges := m.edgeFaces()
+	for e, faces := range edges {
+		if len(faces) > 2 {
+			return fmt.Errorf("edge from vertex %d to %d is shared by %d faces", e[0], e[1], len(faces))
+		}
+	}
+
+	// Faces around each vertex are connected if they can be reached from each other across edges at the vertex
+	around := make([][]int, len(m.Vertices))
+	for i, f := range m.Faces {
+		for _, v := range f {
+			around[v] = append(around[v], i)
+		}
+	}
+	for v, faces := range around {
+		if len(faces) == 0 {
+			continue
+		}
+		reached := map[int]bool{faces[0]: true}
+		stack := []int{faces[0]}
+		for len(stack) > 0 {
+			f := m.Faces[stack[len(stack)-1]]
+			stack = stack[:len(stack)-1]
+			for _, other := range f {
+				if other == v {
+					continue
+				}
+				a, b := v, other
+				if a > b {
+					a, b = b, a
+				}
+				for _, next := range edges[meshEdge{a, b}] {
+					if !reached[next] {
+						reached[next] = true
+						stack = append(stack, next)
+					}
+				}
+			}
+		}
+		if len(reached) != len(faces) {
+			return fmt.Errorf("vertex %d joins faces which are not connected to each other", v)
+		}
+	}
+	return nil
+}
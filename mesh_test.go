@@ -0,0 +1,303 @@
+package space
+
+import (
+	"math"
+	"testing"
+)
+
+// newTestCube creates a cube from the origin to (1, 1, 1)
+// Vertex i is at (i&1, i>>1&1, i>>2&1).
+func newTestCube() Mesh {
+	vertices := make([]Cartesian, 8)
+	for i := range vertices {
+		vertices[i] = Cartesian{float64(i & 1), float64(i >> 1 & 1), float64(i >> 2 & 1)}
+	}
+	return NewMesh(vertices, [][3]int{
+		{0, 2, 3}, {0, 3, 1}, // -Z
+		{4, 5, 7}, {4, 7, 6}, // +Z
+		{0, 1, 5}, {0, 5, 4}, // -Y
+		{2, 6, 7}, {2, 7, 3}, // +Y
+		{0, 4, 6}, {0, 6, 2}, // -X
+		{1, 3, 7}, {1, 7, 5}, // +X
+	})
+}
+
+// newTestOctahedron creates an octahedron with a vertex on each axis, one unit from the origin
+func newTestOctahedron() Mesh {
+	return NewMesh(
+		[]Cartesian{
+			AxisX.Cartesian, AxisXN.Cartesian,
+			AxisY.Cartesian, AxisYN.Cartesian,
+			AxisZ.Cartesian, AxisZN.Cartesian,
+		},
+		[][3]int{
+			{0, 2, 4}, {2, 1, 4}, {1, 3, 4}, {3, 0, 4},
+			{2, 0, 5}, {1, 2, 5}, {3, 1, 5}, {0, 3, 5},
+		},
+	)
+}
+
+func TestMeshProperties(t *testing.T) {
+	cases := []struct {
+		Mesh     Mesh
+		Area     float64
+		Volume   float64
+		Centroid Cartesian
+		Bounds   AABB
+	}{
+		{
+			Mesh:     newTestCube(),
+			Area:     6,
+			Volume:   1,
+			Centroid: Cartesian{0.5, 0.5, 0.5},
+			Bounds:   NewAABB(Cartesian{0, 0, 0}, Cartesian{1, 1, 1}),
+		},
+		{
+			Mesh:     newTestOctahedron(),
+			Area:     4 * math.Sqrt(3),
+			Volume:   4.0 / 3,
+			Centroid: Cartesian{0, 0, 0},
+			Bounds:   unitAABB,
+		},
+		{
+			// A small solid still has the centroid of its volume
+			Mesh: NewMesh(
+				[]Cartesian{{0, 0, 0}, {0.01, 0, 0}, {0, 0.01, 0}, {0, 0, 0.01}},
+				[][3]int{{0, 2, 1}, {0, 1, 3}, {0, 3, 2}, {1, 2, 3}},
+			),
+			Area:     (3 + math.Sqrt(3)) / 2 * 1e-4,
+			Volume:   1e-6 / 6,
+			Centroid: Cartesian{0.0025, 0.0025, 0.0025},
+			Bounds:   NewAABB(Cartesian{0, 0, 0}, Cartesian{0.01, 0.01, 0.01}),
+		},
+		{
+			// Only the bottom of the cube, which encloses nothing
+			Mesh:     NewMesh(newTestCube().Vertices, newTestCube().Faces[:2]),
+			Area:     1,
+			Volume:   0,
+			Centroid: Cartesian{0.5, 0.5, 0},
+			Bounds:   NewAABB(Cartesian{0, 0, 0}, Cartesian{1, 1, 1}),
+		},
+		{
+			// No faces at all
+			Mesh:     NewMesh([]Cartesian{{0, 0, 0}, {2, 4, 6}}, nil),
+			Area:     0,
+			Volume:   0,
+			Centroid: Cartesian{1, 2, 3},
+			Bounds:   NewAABB(Cartesian{0, 0, 0}, Cartesian{2, 4, 6}),
+		},
+		{
+			Mesh:     Mesh{},
+			Area:     0,
+			Volume:   0,
+			Centroid: Cartesian{0, 0, 0},
+			Bounds:   AABB{},
+		},
+	}
+	for i, c := range cases {
+		if actual := c.Mesh.Area(); !near(c.Area, actual) {
+			t.Fatalf("Area %v failed:\n\tExpected: %v,\n\tActual: %v", i, c.Area, actual)
+		}
+		if actual := c.Mesh.Volume(); !near(c.Volume, actual) {
+			t.Fatalf("Volume %v failed:\n\tExpected: %v,\n\tActual: %v", i, c.Volume, actual)
+		}
+		if actual := c.Mesh.Centroid(); !CartesiansEqual(c.Centroid, actual) {
+			t.Fatalf("Centroid %v failed:\n\tExpected: %v,\n\tActual: %v", i, c.Centroid, actual)
+		}
+		if actual := c.Mesh.Bounds(); !AABBsEqual(c.Bounds, actual) {
+			t.Fatalf("Bounds %v failed:\n\tExpected: %v,\n\tActual: %v", i, c.Bounds, actual)
+		}
+	}
+
+	// Reversing every face turns the mesh inside out
+	inside := newTestCube()
+	for i, f := range inside.Faces {
+		inside.Faces[i] = [3]int{f[0], f[2], f[1]}
+	}
+	if actual := inside.Volume(); !near(-1, actual) {
+		t.Fatalf("Volume failed. Inside out cube had volume %v", actual)
+	}
+}
+
+func TestMeshNormals(t *testing.T) {
+	cube := newTestCube()
+	expectedFaces := []Cartesian{
+		AxisZN.Cartesian, AxisZ.Cartesian,
+		AxisYN.Cartesian, AxisY.Cartesian,
+		AxisXN.Cartesian, AxisX.Cartesian,
+	}
+	for i, n := range cube.FaceNormals() {
+		if expected := expectedFaces[i/2]; !CartesiansEqual(expected, n) {
+			t.Fatalf("FaceNormals %v failed:\n\tExpected: %v,\n\tActual: %v", i, expected, n)
+		}
+	}
+
+	// Each vertex of an octahedron is surrounded by four equal faces, so its normal points straight out
+	octahedron := newTestOctahedron()
+	for i, n := range octahedron.VertexNormals() {
+		if expected := octahedron.Vertices[i]; !CartesiansEqual(expected, n) {
+			t.Fatalf("VertexNormals %v failed:\n\tExpected: %v,\n\tActual: %v", i, expected, n)
+		}
+	}
+
+	// Small meshes still have normals of length one
+	small := octahedron.Transform(NewScaleMatrix(1e-4, 1e-4, 1e-4))
+	for i, n := range small.VertexNormals() {
+		if expected := octahedron.Vertices[i]; !CartesiansEqual(expected, n) {
+			t.Fatalf("VertexNormals %v failed on a small mesh:\n\tExpected: %v,\n\tActual: %v", i, expected, n)
+		}
+	}
+
+	// Unused vertices have no normal
+	unused := NewMesh(append(octahedron.Vertices, Cartesian{5, 5, 5}), octahedron.Faces)
+	if n := unused.VertexNormals()[6]; !CartesiansEqual(Cartesian{}, n) {
+		t.Fatalf("VertexNormals failed. Unused vertex had normal %v", n)
+	}
+}
+
+func TestMeshTransform(t *testing.T) {
+	cube := newTestCube()
+	cases := []struct {
+		Matrix   Matrix
+		Volume   float64
+		Centroid Cartesian
+	}{
+		{
+			Matrix:   NewTransformBuilder().ScaleUniform(2).Translate(Cartesian{1, 2, 3}).Matrix(),
+			Volume:   8,
+			Centroid: Cartesian{2, 3, 4},
+		},
+		{
+			Matrix:   NewTransformBuilder().RotateAxisAngle(OctantXYZ.Cartesian, rad(1, 3)).Matrix(),
+			Volume:   1,
+			Centroid: NewAxisAngleMatrix(OctantXYZ.Cartesian, rad(1, 3)).Apply(Cartesian{0.5, 0.5, 0.5}),
+		},
+		{
+			// Mirroring reverses the faces, so the cube is still right side out
+			Matrix:   NewReflectionMatrixYZ(),
+			Volume:   1,
+			Centroid: Cartesian{-0.5, 0.5, 0.5},
+		},
+	}
+	for i, c := range cases {
+		actual := cube.Transform(c.Matrix)
+		if err := actual.Validate(); err != nil {
+			t.Fatalf("Transform %v failed. Mesh was invalid: %v", i, err)
+		}
+		if !near(c.Volume, actual.Volume()) {
+			t.Fatalf("Transform %v failed. Volume was not equal:\n\tExpected: %v,\n\tActual: %v", i, c.Volume, actual.Volume())
+		}
+		if !CartesiansEqual(c.Centroid, actual.Centroid()) {
+			t.Fatalf("Transform %v failed. Centroid was not equal:\n\tExpected: %v,\n\tActual: %v", i, c.Centroid, actual.Centroid())
+		}
+	}
+	if !CartesiansEqual(Cartesian{1, 1, 1}, cube.Vertices[7]) || cube.Faces[0] != [3]int{0, 2, 3} {
+		t.Fatalf("Transform failed. Original mesh was changed: %v", cube)
+	}
+}
+
+func TestMeshWeld(t *testing.T) {
+	// Give every face its own corners, nudged by less than MinErr
+	cube := newTestCube()
+	soup := Mesh{}
+	for i, f := range cube.Faces {
+		nudge := float64(i%3) * MinErr / 4
+		for j, v := range f {
+			soup.Vertices = append(soup.Vertices, cube.Vertices[v].Translate(Cartesian{nudge, -nudge, nudge}).Cartesian())
+			f[j] = len(soup.Vertices) - 1
+		}
+		soup.Faces = append(soup.Faces, f)
+	}
+	// A sliver face which collapses
+	soup.Vertices = append(soup.Vertices, Cartesian{0, 0, MinErr / 2})
+	soup.Faces = append(soup.Faces, [3]int{0, len(soup.Vertices) - 1, 1})
+
+	if soup.Closed() || soup.Validate() != nil {
+		t.Fatalf("Weld failed. Unwelded mesh was not open and valid")
+	}
+	welded := soup.Weld()
+	if len(welded.Vertices) != 8 || len(welded.Faces) != 12 {
+		t.Fatalf("Weld failed. Expected 8 vertices and 12 faces, got %v and %v", len(welded.Vertices), len(welded.Faces))
+	}
+	if err := welded.Validate(); err != nil || !welded.Closed() {
+		t.Fatalf("Weld failed. Welded mesh was not closed and valid: %v", err)
+	}
+	if !near(1, welded.Volume()) {
+		t.Fatalf("Weld failed. Expected volume 1, got %v", welded.Volume())
+	}
+}
+
+func TestMeshValidate(t *testing.T) {
+	cube := newTestCube()
+	octahedron := newTestOctahedron()
+
+	// Two octahedrons which touch at a single vertex
+	touching := NewMesh(append([]Cartesian{}, octahedron.Vertices...), append([][3]int{}, octahedron.Faces...))
+	offset := len(touching.Vertices)
+	for _, v := range octahedron.Vertices {
+		touching.Vertices = append(touching.Vertices, v.Translate(Cartesian{2, 0, 0}).Cartesian())
+	}
+	for _, f := range octahedron.Faces {
+		for j := range f {
+			f[j] += offset
+			// The -X vertex of the second is the +X vertex of the first
+			if f[j] == offset+1 {
+				f[j] = 0
+			}
+		}
+		touching.Faces = append(touching.Faces, f)
+	}
+
+	cases := []struct {
+		Mesh   Mesh
+		Valid  bool
+		Closed bool
+	}{
+		{cube, true, true},
+		{octahedron, true, true},
+		{NewMesh(cube.Vertices, cube.Faces[2:]), true, false},
+		{Mesh{}, true, true},
+		{
+			// A vertex which doesn't exist
+			NewMesh(cube.Vertices, append([][3]int{{0, 1, 8}}, cube.Faces[1:]...)),
+			false, false,
+		},
+		{
+			// A face which uses a vertex twice
+			NewMesh(cube.Vertices, append([][3]int{{0, 2, 0}}, cube.Faces[1:]...)),
+			false, false,
+		},
+		{
+			// A face which turns the wrong way
+			NewMesh(cube.Vertices, append([][3]int{{0, 3, 2}}, cube.Faces[1:]...)),
+			false, true,
+		},
+		{
+			// A third face on an edge
+			NewMesh(cube.Vertices, append([][3]int{{0, 7, 3}}, cube.Faces...)),
+			false, false,
+		},
+		{touching, false, true},
+	}
+	for i, c := range cases {
+		if err := c.Mesh.Validate(); (err == nil) != c.Valid {
+			t.Fatalf("Validate %v failed:\n\tExpected valid: %v,\n\tActual error: %v", i, c.Valid, err)
+		}
+		if c.Valid && c.Mesh.Closed() != c.Closed {
+			t.Fatalf("Closed %v failed:\n\tExpected: %v,\n\tActual: %v", i, c.Closed, c.Mesh.Closed())
+		}
+	}
+}
+
+func TestMeshTriangles(t *testing.T) {
+	cube := newTestCube()
+	tree := NewBVH(cube.Triangles()...)
+	hit, ok := tree.IntersectRay(NewRay(Cartesian{0.75, 0.25, 5}, AxisZN.Spherical))
+	expected := Hit{Distance: 4, Point: Cartesian{0.75, 0.25, 1}, Normal: Cartesian{0, 0, 1}}
+	if !ok || !HitsEqual(expected, hit.Hit) || hit.Index != 2 {
+		t.Fatalf("Triangles failed. Expected face 2 to be hit at %v, got %v", expected, hit)
+	}
+	if actual := cube.Triangle(hit.Index).Normal(); !CartesiansEqual(AxisZ.Cartesian, actual) {
+		t.Fatalf("Triangle failed. Expected normal %v, got %v", AxisZ.Cartesian, actual)
+	}
+}
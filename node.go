@@ -0,0 +1,220 @@
+package space
+
+// A Node is an Object which exists within a hierarchy (a scene graph)
+// The location, orientation and rotation of a Node are relative to its parent.
+// Nodes are not safe for concurrent use.
+type Node struct {
+	name   string
+	object Object
+
+	parent   *Node
+	children []*Node
+
+	// world is the cached transformation from local space into world space
+	world Matrix
+	// worldValid is false when world must be recalculated
+	worldValid bool
+}
+
+// NewNode creates a node without a parent
+func NewNode(name string, location Cartesian, orientation, rotation Spherical) *Node {
+	return &Node{
+		name:   name,
+		object: *NewObject(location, orientation, rotation),
+	}
+}
+
+// GetName returns the name of the node
+func (n *Node) GetName() string {
+	return n.name
+}
+
+// GetObject returns the object of the node, relative to its parent
+func (n *Node) GetObject() Object {
+	return n.object
+}
+
+// GetLocation returns the location of the node, relative to its parent
+func (n *Node) GetLocation() Cartesian {
+	return n.object.GetLocation()
+}
+
+// SetLocation changes the location of the node, relative to its parent
+func (n *Node) SetLocation(newLocation Cartesian) {
+	n.object.SetLocation(newLocation)
+	n.invalidate()
+}
+
+// GetOrientation returns the orientation of the node, relative to its parent
+func (n *Node) GetOrientation() Spherical {
+	return n.object.GetOrientation()
+}
+
+// SetOrientation changes the orientation of the node, relative to its parent
+func (n *Node) SetOrientation(newOrientation Spherical) {
+	n.object.SetOrientation(newOrientation)
+	n.invalidate()
+}
+
+// GetRotation returns the rotation of the node, relative to its parent
+func (n *Node) GetRotation() Spherical {
+	return n.object.GetRotation()
+}
+
+// SetRotation changes the rotation of the node, relative to its parent
+func (n *Node) SetRotation(newRotation Spherical) {
+	n.object.SetRotation(newRotation)
+	n.invalidate()
+}
+
+// GetBearings returns all properties of the node, relative to its parent
+func (n *Node) GetBearings() (location Cartesian, orientation, rotation Spherical) {
+	return n.object.GetBearings()
+}
+
+// Move changes all properties of the node, relative to its parent
+func (n *Node) Move(location Cartesian, orientation, rotation Spherical) {
+	n.object.Move(location, orientation, rotation)
+	n.invalidate()
+}
+
+// Parent returns the parent of the node, or nil if the node is a root
+func (n *Node) Parent() *Node {
+	return n.parent
+}
+
+// Children returns the children of the node
+func (n *Node) Children() []*Node {
+	children := make([]*Node, len(n.children))
+	copy(children, n.children)
+	return children
+}
+
+// AddChild attaches child to the node, detaching it from any previous parent
+// The child keeps its relative location, orientation and rotation.
+// If child is the node or one of its ancestors, nothing is changed and false is returned.
+func (n *Node) AddChild(child *Node) bool {
+	for a := n; a != nil; a = a.parent {
+		if a == child {
+			return false
+		}
+	}
+	child.Detach()
+	child.parent = n
+	n.children = append(n.children, child)
+	child.invalidate()
+	return true
+}
+
+// RemoveChild detaches child from the node
+// If child is not a child of the node, false is returned.
+func (n *Node) RemoveChild(child *Node) bool {
+	for i, c := range n.children {
+		if c != child {
+			continue
+		}
+		n.children = append(n.children[:i], n.children[i+1:]...)
+		child.parent = nil
+		child.invalidate()
+		return true
+	}
+	return false
+}
+
+// Detach removes the node from its parent, making it a root
+func (n *Node) Detach() {
+	if n.parent != nil {
+		n.parent.RemoveChild(n)
+	}
+}
+
+// Root returns the top most ancestor of the node
+func (n *Node) Root() *Node {
+	root := n
+	for root.parent != nil {
+		root = root.parent
+	}
+	return root
+}
+
+// PathToRoot returns the node followed by each of its ancestors, ending with the root
+func (n *Node) PathToRoot() []*Node {
+	path := []*Node{}
+	for a := n; a != nil; a = a.parent {
+		path = append(path, a)
+	}
+	return path
+}
+
+// Walk calls fn for the node and each of its descendants, parents before children
+// If fn returns false, the walk is stopped and Walk returns false.
+func (n *Node) Walk(fn func(*Node) bool) bool {
+	if !fn(n) {
+		return false
+	}
+	for _, c := range n.children {
+		if !c.Walk(fn) {
+			return false
+		}
+	}
+	return true
+}
+
+// Find returns the first node (the node itself or a descendant) with the given name
+// If no node has the name, nil is returned.
+func (n *Node) Find(name string) *Node {
+	var found *Node
+	n.Walk(func(c *Node) bool {
+		if c.name == name {
+			found = c
+			return false
+		}
+		return true
+	})
+	return found
+}
+
+// WorldMatrix produces a matrix which will transform from the node's local space into world space
+func (n *Node) WorldMatrix() Matrix {
+	if !n.worldValid {
+		n.world = n.object.Matrix()
+		if n.parent != nil {
+			n.world = n.parent.WorldMatrix().Multiply(n.world)
+		}
+		n.worldValid = true
+	}
+	return n.world
+}
+
+// WorldInverseMatrix produces a matrix which will transform from world space into the node's local space
+func (n *Node) WorldInverseMatrix() Matrix {
+	return n.WorldMatrix().RigidInverse()
+}
+
+// WorldLocation returns the location of the node in world space
+func (n *Node) WorldLocation() Cartesian {
+	return n.WorldMatrix().Apply(Cartesian{})
+}
+
+// LocalToWorld transforms v from the node's local space into world space
+func (n *Node) LocalToWorld(v Vector) Vector {
+	return v.Transform(n.WorldMatrix())
+}
+
+// WorldToLocal transforms v from world space into the node's local space
+func (n *Node) WorldToLocal(v Vector) Vector {
+	return v.Transform(n.WorldInverseMatrix())
+}
+
+// invalidate marks the cached world transformation of the node and its descendants as stale
+func (n *Node) invalidate() {
+	if !n.worldValid {
+		// Caching a node always caches its ancestors first,
+		// so the descendants of a stale node are already stale
+		return
+	}
+	n.worldValid = false
+	for _, c := range n.children {
+		c.invalidate()
+	}
+}
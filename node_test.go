@@ -0,0 +1,205 @@
+package space
+
+import (
+	"testing"
+)
+
+// newTestScene builds room -> fixture -> device, with a lamp beside the fixture
+func newTestScene() (room, fixture, device, lamp *Node) {
+	room = NewNode("room", Cartesian{10, 0, 0}, AxisZ.Spherical, AxisY.Spherical)
+	fixture = NewNode("fixture", Cartesian{0, 0, 3}, AxisX.Spherical, AxisY.Spherical)
+	device = NewNode("device", Cartesian{0, 0, 1}, AxisZ.Spherical, AxisY.Spherical)
+	lamp = NewNode("lamp", Cartesian{0, 2, 0}, AxisZ.Spherical, AxisY.Spherical)
+	room.AddChild(fixture)
+	room.AddChild(lamp)
+	fixture.AddChild(device)
+	return room, fixture, device, lamp
+}
+
+func TestNodeWorldLocation(t *testing.T) {
+	cases := []struct {
+		Operation func() Cartesian
+		Expected  Cartesian
+	}{
+		{
+			Operation: func() Cartesian {
+				room, _, _, _ := newTestScene()
+				return room.WorldLocation()
+			},
+			Expected: Cartesian{10, 0, 0},
+		},
+		{
+			Operation: func() Cartesian {
+				_, fixture, _, _ := newTestScene()
+				return fixture.WorldLocation()
+			},
+			Expected: Cartesian{10, 0, 3},
+		},
+		{
+			// The fixture points along X, so the device's local Z is world X
+			Operation: func() Cartesian {
+				_, _, device, _ := newTestScene()
+				return device.WorldLocation()
+			},
+			Expected: Cartesian{11, 0, 3},
+		},
+		{
+			Operation: func() Cartesian {
+				_, _, device, _ := newTestScene()
+				return device.LocalToWorld(AxisY.Cartesian).Cartesian()
+			},
+			Expected: Cartesian{11, 1, 3},
+		},
+		{
+			Operation: func() Cartesian {
+				_, _, device, _ := newTestScene()
+				return device.WorldToLocal(Cartesian{11, 1, 3}).Cartesian()
+			},
+			Expected: AxisY.Cartesian,
+		},
+	}
+	for i, c := range cases {
+		actual := c.Operation()
+		if !CartesiansEqual(c.Expected, actual) {
+			t.Fatalf("WorldLocation %v failed. Cartesians were not equal:\n\tExpected: %v,\n\tActual: %v", i, c.Expected, actual)
+		}
+	}
+}
+
+func TestNodeInvalidation(t *testing.T) {
+	cases := []struct {
+		Operation func(room, fixture, device, lamp *Node)
+		Device    Cartesian
+		Lamp      Cartesian
+	}{
+		{
+			Operation: func(room, fixture, device, lamp *Node) {
+				room.SetLocation(Cartesian{0, 0, 0})
+			},
+			Device: Cartesian{1, 0, 3},
+			Lamp:   Cartesian{0, 2, 0},
+		},
+		{
+			Operation: func(room, fixture, device, lamp *Node) {
+				fixture.SetOrientation(AxisZ.Spherical)
+			},
+			Device: Cartesian{10, 0, 4},
+			Lamp:   Cartesian{10, 2, 0},
+		},
+		{
+			Operation: func(room, fixture, device, lamp *Node) {
+				room.SetRotation(AxisX.Spherical)
+			},
+			Device: Cartesian{10, -1, 3},
+			Lamp:   Cartesian{12, 0, 0},
+		},
+		{
+			Operation: func(room, fixture, device, lamp *Node) {
+				room.Move(Cartesian{0, 0, 5}, AxisZ.Spherical, AxisY.Spherical)
+			},
+			Device: Cartesian{1, 0, 8},
+			Lamp:   Cartesian{0, 2, 5},
+		},
+		{
+			Operation: func(room, fixture, device, lamp *Node) {
+				lamp.AddChild(fixture)
+			},
+			Device: Cartesian{11, 2, 3},
+			Lamp:   Cartesian{10, 2, 0},
+		},
+		{
+			Operation: func(room, fixture, device, lamp *Node) {
+				fixture.Detach()
+			},
+			Device: Cartesian{1, 0, 3},
+			Lamp:   Cartesian{10, 2, 0},
+		},
+	}
+	for i, c := range cases {
+		room, fixture, device, lamp := newTestScene()
+		// Cache the world transformations before changing them
+		device.WorldMatrix()
+		lamp.WorldMatrix()
+		c.Operation(room, fixture, device, lamp)
+		if actual := device.WorldLocation(); !CartesiansEqual(c.Device, actual) {
+			t.Fatalf("Invalidation %v failed. Device Cartesians were not equal:\n\tExpected: %v,\n\tActual: %v", i, c.Device, actual)
+		}
+		if actual := lamp.WorldLocation(); !CartesiansEqual(c.Lamp, actual) {
+			t.Fatalf("Invalidation %v failed. Lamp Cartesians were not equal:\n\tExpected: %v,\n\tActual: %v", i, c.Lamp, actual)
+		}
+	}
+}
+
+func TestNodeAddChild(t *testing.T) {
+	room, fixture, device, lamp := newTestScene()
+	if device.AddChild(room) {
+		t.Fatalf("AddChild failed. An ancestor was added as a child")
+	}
+	if device.AddChild(device) {
+		t.Fatalf("AddChild failed. A node was added as its own child")
+	}
+	if !lamp.AddChild(device) {
+		t.Fatalf("AddChild failed. A node could not be moved to a new parent")
+	}
+	if device.Parent() != lamp {
+		t.Fatalf("AddChild failed. Parent was not updated:\n\tExpected: %v,\n\tActual: %v", lamp.GetName(), device.Parent().GetName())
+	}
+	if len(fixture.Children()) != 0 {
+		t.Fatalf("AddChild failed. Node was not removed from its previous parent")
+	}
+	if room.RemoveChild(device) {
+		t.Fatalf("RemoveChild failed. A grandchild was removed")
+	}
+	if !lamp.RemoveChild(device) || device.Parent() != nil {
+		t.Fatalf("RemoveChild failed. Child was not removed")
+	}
+}
+
+func TestNodeTraversal(t *testing.T) {
+	room, fixture, device, lamp := newTestScene()
+
+	names := []string{}
+	room.Walk(func(n *Node) bool {
+		names = append(names, n.GetName())
+		return true
+	})
+	expectedNames := []string{"room", "fixture", "device", "lamp"}
+	if len(names) != len(expectedNames) {
+		t.Fatalf("Walk failed:\n\tExpected: %v,\n\tActual: %v", expectedNames, names)
+	}
+	for i := range names {
+		if names[i] != expectedNames[i] {
+			t.Fatalf("Walk failed:\n\tExpected: %v,\n\tActual: %v", expectedNames, names)
+		}
+	}
+
+	cases := []struct {
+		Name     string
+		Expected *Node
+	}{
+		{"room", room},
+		{"fixture", fixture},
+		{"device", device},
+		{"lamp", lamp},
+		{"missing", nil},
+	}
+	for i, c := range cases {
+		if actual := room.Find(c.Name); actual != c.Expected {
+			t.Fatalf("Find %v failed:\n\tExpected: %v,\n\tActual: %v", i, c.Expected, actual)
+		}
+	}
+
+	path := device.PathToRoot()
+	expectedPath := []*Node{device, fixture, room}
+	if len(path) != len(expectedPath) {
+		t.Fatalf("PathToRoot failed:\n\tExpected: %v,\n\tActual: %v", expectedPath, path)
+	}
+	for i := range path {
+		if path[i] != expectedPath[i] {
+			t.Fatalf("PathToRoot failed:\n\tExpected: %v,\n\tActual: %v", expectedPath, path)
+		}
+	}
+	if device.Root() != room {
+		t.Fatalf("Root failed:\n\tExpected: %v,\n\tActual: %v", room, device.Root())
+	}
+}
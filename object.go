@@ -64,3 +64,56 @@ func (o *Object) Move(location Cartesian, orientation, rotation Spherical) {
 	o.orientation = orientation
 	o.rotation = normalizedRotation
 }
+
+// Quaternion returns the orientation and rotation of the object as a single Quaternion
+// The Quaternion turns Z towards the orientation and Y towards the rotation.
+// If the rotation has no length, only the orientation is described.
+func (o Object) Quaternion() Quaternion {
+	// The rotation is only rounding error when it is short compared to the orientation, whatever their lengths
+	orientationLength := o.orientation.Length()
+	if orientationLength == 0 || o.rotation.Length() <= MinErr*orientationLength {
+		return o.orientation.Quaternion()
+	}
+	z := o.orientation.Normalize().Cartesian()
+	y := o.rotation.Normalize().Cartesian()
+	x := y.Cross(z).Cartesian()
+	m := Matrix{
+		{x.X, y.X, z.X, 0},
+		{x.Y, y.Y, z.Y, 0},
+		{x.Z, y.Z, z.Z, 0},
+		{0, 0, 0, 1},
+	}
+	return m.Quaternion()
+}
+
+// Matrix produces a matrix which will transform from the object's local space into world space
+// Local Z is turned towards the orientation, local Y towards the rotation, and the origin is moved to the location
+func (o Object) Matrix() Matrix {
+	return o.location.TranslationMatrix().Multiply(o.Quaternion().RotationMatrix())
+}
+
+// InverseMatrix produces a matrix which will transform from world space into the object's local space
+func (o Object) InverseMatrix() Matrix {
+	return o.Matrix().RigidInverse()
+}
+
+// LocalToWorld transforms v from the object's local space into world space
+func (o Object) LocalToWorld(v Vector) Vector {
+	return v.Transform(o.Matrix())
+}
+
+// WorldToLocal transforms v from world space into the object's local space
+func (o Object) WorldToLocal(v Vector) Vector {
+	return v.Transform(o.InverseMatrix())
+}
+
+// LookAt turns the object's orientation towards target, with its rotation towards up
+// The rotation is the portion of up which is orthogonal to the new orientation, as in NewObject.
+// If target is at the object's location, nothing is changed.
+func (o *Object) LookAt(target, up Vector) {
+	forward := target.Subtract(o.location)
+	if near(forward.Length(), 0) {
+		return
+	}
+	o.Move(o.location, forward.Spherical(), up.Spherical())
+}
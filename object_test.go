@@ -95,3 +95,149 @@ func ObjectsEqual(a, b *Object) bool {
 	}
 	return true
 }
+
+func TestObjectQuaternion(t *testing.T) {
+	cases := []struct {
+		Object   *Object
+		Expected Quaternion
+	}{
+		{
+			Object:   NewObject(Origin.Cartesian, AxisZ.Spherical, AxisY.Spherical),
+			Expected: NewIdentityQuaternion(),
+		},
+		{
+			Object:   NewObject(AxisX3.Cartesian, AxisZ3.Spherical, AxisXN.Spherical),
+			Expected: NewAxisAngleQuaternion(AxisZ.Cartesian, rad(1, 2)),
+		},
+		{
+			Object:   NewObject(Origin.Cartesian, AxisX.Spherical, AxisY.Spherical),
+			Expected: NewAxisAngleQuaternion(AxisY.Cartesian, rad(1, 2)),
+		},
+		{
+			Object:   NewObject(Origin.Cartesian, AxisX.Spherical, AxisZ.Spherical),
+			Expected: NewAxisAngleQuaternion(AxisY.Cartesian, rad(1, 2)).Multiply(NewAxisAngleQuaternion(AxisZ.Cartesian, rad(1, 2))),
+		},
+		{
+			// Rotation which is parallel to orientation has no orthogonal portion
+			Object:   NewObject(Origin.Cartesian, OctantXYZ.Spherical, OctantXYZ3.Spherical),
+			Expected: OctantXYZ.Spherical.Quaternion(),
+		},
+		{
+			// Short orientations and rotations turn the same as long ones
+			Object:   NewObject(Origin.Cartesian, NewSpherical(1e-7, 0, rad(1, 2)), NewSpherical(1e-7, 0, 0)),
+			Expected: NewAxisAngleQuaternion(AxisY.Cartesian, rad(1, 2)).Multiply(NewAxisAngleQuaternion(AxisZ.Cartesian, rad(1, 2))),
+		},
+	}
+	for i, c := range cases {
+		actual := c.Object.Quaternion()
+		if !QuaternionsEqual(c.Expected, actual) {
+			t.Fatalf("Quaternion %v failed. Quaternions were not equal:\n\tExpected: %v,\n\tActual: %v", i, c.Expected, actual)
+		}
+		orientation := actual.Rotate(AxisZ.Cartesian).Spherical()
+		if !SphericalsEqual(c.Object.GetOrientation().Scale(1/c.Object.GetOrientation().R).Spherical(), orientation) {
+			t.Fatalf("Quaternion %v failed. Z did not turn towards orientation:\n\tExpected: %v,\n\tActual: %v", i, c.Object.GetOrientation(), orientation)
+		}
+	}
+}
+
+func TestObjectLocalToWorld(t *testing.T) {
+	cases := []struct {
+		Object   *Object
+		Local    Vector
+		Expected Cartesian
+	}{
+		{
+			Object:   NewObject(Origin.Cartesian, AxisZ.Spherical, AxisY.Spherical),
+			Local:    Cartesian{2, 3, 5},
+			Expected: Cartesian{2, 3, 5},
+		},
+		{
+			Object:   NewObject(Cartesian{1, 1, 1}, AxisZ.Spherical, AxisY.Spherical),
+			Local:    Cartesian{2, 3, 5},
+			Expected: Cartesian{3, 4, 6},
+		},
+		{
+			Object:   NewObject(Cartesian{1, 1, 1}, AxisX.Spherical, AxisY.Spherical),
+			Local:    AxisZ3.Spherical,
+			Expected: Cartesian{4, 1, 1},
+		},
+		{
+			Object:   NewObject(Cartesian{1, 1, 1}, AxisX.Spherical, AxisZ.Spherical),
+			Local:    AxisY.Cartesian,
+			Expected: Cartesian{1, 1, 2},
+		},
+		{
+			Object:   NewObject(Cartesian{1, 1, 1}, AxisX.Spherical, AxisZ.Spherical),
+			Local:    AxisX.Cartesian,
+			Expected: Cartesian{1, 2, 1},
+		},
+		{
+			Object:   NewObject(Cartesian{-2, 0, 7}, OctantXNYZ3.Spherical, AxisZ.Spherical),
+			Local:    AxisZ3.Cartesian,
+			Expected: Cartesian{-2, 0, 7}.Translate(OctantXNYZ3.Cartesian).Cartesian(),
+		},
+	}
+	for i, c := range cases {
+		actual := c.Object.LocalToWorld(c.Local).Cartesian()
+		if !CartesiansEqual(c.Expected, actual) {
+			t.Fatalf("LocalToWorld %v failed. Cartesians were not equal:\n\tExpected: %v,\n\tActual: %v", i, c.Expected, actual)
+		}
+		local := c.Object.WorldToLocal(actual).Cartesian()
+		if !CartesiansEqual(c.Local.Cartesian(), local) {
+			t.Fatalf("WorldToLocal %v failed. Cartesians were not equal:\n\tExpected: %v,\n\tActual: %v", i, c.Local, local)
+		}
+	}
+}
+
+func TestObjectInverseMatrix(t *testing.T) {
+	cases := []*Object{
+		NewObject(Origin.Cartesian, AxisZ.Spherical, AxisY.Spherical),
+		NewObject(Cartesian{2, 3, 5}, AxisXN.Spherical, AxisZ.Spherical),
+		NewObject(Cartesian{-7, 1, 0}, OctantNXYNZ3.Spherical, OctantXYZ.Spherical),
+	}
+	for i, o := range cases {
+		actual := o.Matrix().Multiply(o.InverseMatrix())
+		if !MatriciesEqual(NewIdentityMatrix(), actual) {
+			t.Fatalf("InverseMatrix %v failed. Matrix * InverseMatrix was not identity:\n\tActual: %v", i, actual)
+		}
+	}
+}
+
+func TestObjectLookAt(t *testing.T) {
+	cases := []struct {
+		Object   *Object
+		Target   Vector
+		Up       Vector
+		Expected *Object
+	}{
+		{
+			Object:   NewObject(Origin.Cartesian, AxisZ.Spherical, AxisY.Spherical),
+			Target:   AxisX3.Cartesian,
+			Up:       AxisZ.Cartesian,
+			Expected: NewObject(Origin.Cartesian, AxisX3.Spherical, AxisZ.Spherical),
+		},
+		{
+			Object:   NewObject(Cartesian{1, 1, 1}, AxisZ.Spherical, AxisY.Spherical),
+			Target:   Cartesian{1, -2, 1},
+			Up:       OctantXYZ.Spherical,
+			Expected: NewObject(Cartesian{1, 1, 1}, AxisYN3.Spherical, OctantXYZ.Spherical),
+		},
+		{
+			// Looking at its own location changes nothing
+			Object:   NewObject(Cartesian{1, 1, 1}, AxisX.Spherical, AxisY.Spherical),
+			Target:   Cartesian{1, 1, 1},
+			Up:       AxisZ.Cartesian,
+			Expected: NewObject(Cartesian{1, 1, 1}, AxisX.Spherical, AxisY.Spherical),
+		},
+	}
+	for i, c := range cases {
+		c.Object.LookAt(c.Target, c.Up)
+		if !ObjectsEqual(c.Expected, c.Object) {
+			t.Fatalf("LookAt %v failed. Objects were not equal:\n\tExpected: %v,\n\tActual: %v", i, c.Expected, c.Object)
+		}
+		expected := NewLookAtMatrix(c.Expected.GetLocation(), c.Target, c.Up)
+		if c.Target.DistanceTo(c.Expected.GetLocation()) > MinErr && !MatriciesEqual(expected, c.Object.Matrix()) {
+			t.Fatalf("LookAt %v failed. Matrix did not match NewLookAtMatrix:\n\tExpected: %v,\n\tActual: %v", i, expected, c.Object.Matrix())
+		}
+	}
+}
@@ -0,0 +1,365 @@
+package space
+
+import (
+	"sort"
+)
+
+const (
+	// octreeCapacity is how many objects a node holds before it splits into eight
+	octreeCapacity = 8
+	// octreeMaxDepth is how many times the bounds of an Octree can be split
+	octreeMaxDepth = 8
+)
+
+// Octree finds objects in space by repeatedly splitting it into eight smaller boxes
+// Each object is held as a sphere around its location. Objects move freely, so the Octree
+// only knows where they were at their last Insert or Update; call Update after moving an object.
+// Objects outside of the bounds of the Octree are still found, but less efficiently.
+// Octrees are not safe for concurrent use.
+type Octree struct {
+	root *octreeNode
+	// entries finds the entry of each object, so that it can be moved or removed
+	entries map[*Object]*octreeEntry
+}
+
+type octreeNode struct {
+	bounds   AABB
+	depth    int
+	parent   *octreeNode
+	children *[8]*octreeNode
+	// entries are the objects which fit in bounds, but not in any of children
+	entries []*octreeEntry
+}
+
+type octreeEntry struct {
+	object *Object
+	center Cartesian
+	radius float64
+	node   *octreeNode
+}
+
+// ObjectHit is where a Ray meets an Object
+type ObjectHit struct {
+	Hit
+	Object *Object
+}
+
+// NewOctree creates an empty Octree which splits up bounds
+func NewOctree(bounds AABB) *Octree {
+	return &Octree{
+		root:    &octreeNode{bounds: bounds},
+		entries: map[*Object]*octreeEntry{},
+	}
+}
+
+// Len returns the number of objects in t
+func (t *Octree) Len() int {
+	return len(t.entries)
+}
+
+// Insert adds o to t, as a sphere of radius around its location
+// If o is already in t, it is updated with the new radius instead.
+func (t *Octree) Insert(o *Object, radius float64) {
+	if e, ok := t.entries[o]; ok {
+		e.radius = radius
+		t.Update(o)
+		return
+	}
+	e := &octreeEntry{
+		object: o,
+		center: o.GetLocation(),
+		radius: radius,
+	}
+	t.entries[o] = e
+	t.place(t.root, e)
+}
+
+// Remove takes o out of t
+// If o is not in t, false is returned.
+func (t *Octree) Remove(o *Object) bool {
+	e, ok := t.entries[o]
+	if !ok {
+		return false
+	}
+	delete(t.entries, o)
+	n := e.node
+	n.remove(e)
+	t.collapse(n)
+	return true
+}
+
+// Update moves o within t to its current location
+// Only the branches of t around its old and new locations are changed.
+// If o is not in t, false is returned.
+func (t *Octree) Update(o *Object) bool {
+	e, ok := t.entries[o]
+	if !ok {
+		return false
+	}
+	e.center = o.GetLocation()
+	old := e.node
+	old.remove(e)
+
+	// Climb until the object fits, then find its place from there
+	n := old
+	for n.parent != nil && !n.fits(e) {
+		n = n.parent
+	}
+	t.place(n, e)
+	if e.node != old {
+		t.collapse(old)
+	}
+	return true
+}
+
+// UpdateAll moves every object within t to its current location
+func (t *Octree) UpdateAll() {
+	for o := range t.entries {
+		t.Update(o)
+	}
+}
+
+// place puts e in the smallest node under n which it fits in, splitting nodes which are full
+func (t *Octree) place(n *octreeNode, e *octreeEntry) {
+	for n.children != nil {
+		child := n.childFor(e)
+		if child == nil {
+			break
+		}
+		n = child
+	}
+	n.entries = append(n.entries, e)
+	e.node = n
+	if n.children == nil && len(n.entries) > octreeCapacity && n.depth < octreeMaxDepth {
+		n.split()
+	}
+}
+
+// collapse merges the children of n and its parents back together when they hold few enough objects
+func (t *Octree) collapse(n *octreeNode) {
+	for ; n != nil; n = n.parent {
+		if n.children == nil {
+			continue
+		}
+		count := len(n.entries)
+		for _, c := range n.children {
+			if c.children != nil {
+				return
+			}
+			count += len(c.entries)
+		}
+		if count > octreeCapacity {
+			return
+		}
+		for _, c := range n.children {
+			for _, e := range c.entries {
+				e.node = n
+				n.entries = append(n.entries, e)
+			}
+		}
+		n.children = nil
+	}
+}
+
+// split divides n into eight children and moves down the entries which fit in them
+func (n *octreeNode) split() {
+	center := n.bounds.Center()
+	n.children = &[8]*octreeNode{}
+	for i := range n.children {
+		b := n.bounds
+		if i&1 == 0 {
+			b.Max.X = center.X
+		} else {
+			b.Min.X = center.X
+		}
+		if i&2 == 0 {
+			b.Max.Y = center.Y
+		} else {
+			b.Min.Y = center.Y
+		}
+		if i&4 == 0 {
+			b.Max.Z = center.Z
+		} else {
+			b.Min.Z = center.Z
+		}
+		n.children[i] = &octreeNode{
+			bounds: b,
+			depth:  n.depth + 1,
+			parent: n,
+		}
+	}
+
+	entries := n.entries
+	n.entries = nil
+	for _, e := range entries {
+		if child := n.childFor(e); child != nil {
+			child.entries = append(child.entries, e)
+			e.node = child
+		} else {
+			n.entries = append(n.entries, e)
+		}
+	}
+}
+
+// childFor returns the child of n which e fits in, or nil if e doesn't fit in any
+func (n *octreeNode) childFor(e *octreeEntry) *octreeNode {
+	center := n.bounds.Center()
+	i := 0
+	if e.center.X >= center.X {
+		i |= 1
+	}
+	if e.center.Y >= center.Y {
+		i |= 2
+	}
+	if e.center.Z >= center.Z {
+		i |= 4
+	}
+	if child := n.children[i]; child.fits(e) {
+		return child
+	}
+	return nil
+}
+
+// fits returns true if the whole sphere of e is within the bounds of n
+func (n *octreeNode) fits(e *octreeEntry) bool {
+	b := n.bounds
+	c := e.center
+	r := e.radius
+	return c.X-r >= b.Min.X && c.X+r <= b.Max.X &&
+		c.Y-r >= b.Min.Y && c.Y+r <= b.Max.Y &&
+		c.Z-r >= b.Min.Z && c.Z+r <= b.Max.Z
+}
+
+// remove takes e out of the entries of n
+func (n *octreeNode) remove(e *octreeEntry) {
+	for i, candidate := range n.entries {
+		if candidate == e {
+			last := len(n.entries) - 1
+			n.entries[i] = n.entries[last]
+			n.entries[last] = nil
+			n.entries = n.entries[:last]
+			return
+		}
+	}
+}
+
+// visit calls fn for each entry in t, skipping the nodes which enter rejects
+// The root is always entered, because it may hold objects outside of its bounds.
+func (t *Octree) visit(enter func(n *octreeNode) bool, fn func(e *octreeEntry)) {
+	var walk func(n *octreeNode)
+	walk = func(n *octreeNode) {
+		for _, e := range n.entries {
+			fn(e)
+		}
+		if n.children == nil {
+			return
+		}
+		for _, c := range n.children {
+			if enter(c) {
+				walk(c)
+			}
+		}
+	}
+	walk(t.root)
+}
+
+// InBox returns the objects in t which touch b, in no particular order
+func (t *Octree) InBox(b AABB) []*Object {
+	objects := []*Object{}
+	t.visit(
+		func(n *octreeNode) bool {
+			return n.bounds.Intersects(b)
+		},
+		func(e *octreeEntry) {
+			if e.bounds().Intersects(b) {
+				objects = append(objects, e.object)
+			}
+		},
+	)
+	return objects
+}
+
+// InSphere returns the objects in t which touch the sphere at center with radius, in no particular order
+func (t *Octree) InSphere(center Vector, radius float64) []*Object {
+	c := center.Cartesian()
+	objects := []*Object{}
+	t.visit(
+		func(n *octreeNode) bool {
+			return distanceSquaredToAABB(c, n.bounds) <= radius*radius
+		},
+		func(e *octreeEntry) {
+			reach := radius + e.radius
+			if distanceSquared(c, e.center) <= reach*reach {
+				objects = append(objects, e.object)
+			}
+		},
+	)
+	return objects
+}
+
+// InFrustum returns the objects in t which are at least partly inside f, in no particular order
+func (t *Octree) InFrustum(f Frustum) []*Object {
+	objects := []*Object{}
+	t.visit(
+		func(n *octreeNode) bool {
+			return f.IntersectsBox(n.bounds) != Outside
+		},
+		func(e *octreeEntry) {
+			if f.IntersectsSphere(e.center, e.radius) != Outside {
+				objects = append(objects, e.object)
+			}
+		},
+	)
+	return objects
+}
+
+// IntersectRay returns where r meets the objects in t, from closest to furthest
+// Objects with no radius are points, which r will almost never meet.
+func (t *Octree) IntersectRay(r Ray) []ObjectHit {
+	hits := []ObjectHit{}
+	t.visit(
+		func(n *octreeNode) bool {
+			_, ok := r.IntersectAABB(n.bounds)
+			return ok
+		},
+		func(e *octreeEntry) {
+			if hit, ok := r.IntersectSphere(e.center, e.radius); ok {
+				hits = append(hits, ObjectHit{Hit: hit, Object: e.object})
+			}
+		},
+	)
+	sort.Slice(hits, func(i, j int) bool {
+		return hits[i].Distance < hits[j].Distance
+	})
+	return hits
+}
+
+// bounds returns the box around the sphere of e
+func (e *octreeEntry) bounds() AABB {
+	r := Cartesian{e.radius, e.radius, e.radius}
+	return AABB{
+		Min: e.center.Subtract(r).Cartesian(),
+		Max: e.center.Translate(r).Cartesian(),
+	}
+}
+
+// distanceSquaredToAABB returns the square of the distance from c to the closest point in b
+func distanceSquaredToAABB(c Cartesian, b AABB) float64 {
+	closest := Cartesian{
+		X: clamp(c.X, b.Min.X, b.Max.X),
+		Y: clamp(c.Y, b.Min.Y, b.Max.Y),
+		Z: clamp(c.Z, b.Min.Z, b.Max.Z),
+	}
+	return distanceSquared(c, closest)
+}
+
+// clamp returns v limited to the range [low, high]
+func clamp(v, low, high float64) float64 {
+	if v < low {
+		return low
+	}
+	if v > high {
+		return high
+	}
+	return v
+}
@@ -0,0 +1,272 @@
+package space
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// randomOctreeObjects returns n objects spread through a 100 unit cube, with radii up to 3
+// A few are outside of the cube.
+func randomOctreeObjects(n int, seed int64) ([]*Object, []float64) {
+	r := rand.New(rand.NewSource(seed))
+	objects := make([]*Object, n)
+	radii := make([]float64, n)
+	for i, l := range randomCartesians(r, n, -5, 105) {
+		objects[i] = NewObject(l, AxisZ.Spherical, AxisY.Spherical)
+		radii[i] = r.Float64() * 3
+	}
+	return objects, radii
+}
+
+// octreeIndices returns the indices in objects of each of found
+func octreeIndices(objects, found []*Object) []int {
+	index := make(map[*Object]int, len(objects))
+	for i, o := range objects {
+		index[o] = i
+	}
+	indices := make([]int, len(found))
+	for i, o := range found {
+		if j, ok := index[o]; ok {
+			indices[i] = j
+		} else {
+			indices[i] = -1
+		}
+	}
+	return indices
+}
+
+// checkOctree fails if any object is in a node which it doesn't fit in
+func checkOctree(t *testing.T, tree *Octree) {
+	count := 0
+	var walk func(n *octreeNode)
+	walk = func(n *octreeNode) {
+		for _, e := range n.entries {
+			count++
+			if e.node != n || tree.entries[e.object] != e {
+				t.Fatalf("Octree failed. Entry %v was lost", e.object)
+			}
+			if n.parent != nil && !n.fits(e) {
+				t.Fatalf("Octree failed. Entry at %v did not fit in %v", e.center, n.bounds)
+			}
+			if n.children != nil && n.childFor(e) != nil {
+				t.Fatalf("Octree failed. Entry at %v was not placed in the smallest node", e.center)
+			}
+		}
+		if n.children != nil {
+			for _, c := range n.children {
+				walk(c)
+			}
+		}
+	}
+	walk(tree.root)
+	if count != tree.Len() {
+		t.Fatalf("Octree failed. Expected %v objects, found %v", tree.Len(), count)
+	}
+}
+
+// checkOctreeQueries compares the queries of tree against checking every object
+func checkOctreeQueries(t *testing.T, tree *Octree, objects []*Object, radii []float64) {
+	boxes := []AABB{
+		NewAABB(Cartesian{0, 0, 0}, Cartesian{100, 100, 100}),
+		NewAABB(Cartesian{10, 20, 30}, Cartesian{40, 50, 60}),
+		NewAABB(Cartesian{-10, -10, -10}, Cartesian{2, 2, 2}),
+	}
+	for i, b := range boxes {
+		expected := []int{}
+		for j, o := range objects {
+			e := octreeEntry{center: o.GetLocation(), radius: radii[j]}
+			if e.bounds().Intersects(b) {
+				expected = append(expected, j)
+			}
+		}
+		if actual := octreeIndices(objects, tree.InBox(b)); !IndexSetsEqual(expected, actual) {
+			t.Fatalf("InBox %v failed. Expected %v objects, got %v", i, len(expected), len(actual))
+		}
+	}
+
+	spheres := []struct {
+		Center Cartesian
+		Radius float64
+	}{
+		{Cartesian{50, 50, 50}, 20},
+		{Cartesian{0, 0, 0}, 10},
+		{Cartesian{104, 50, 50}, 3},
+	}
+	for i, s := range spheres {
+		expected := []int{}
+		for j, o := range objects {
+			if o.GetLocation().DistanceTo(s.Center) <= s.Radius+radii[j] {
+				expected = append(expected, j)
+			}
+		}
+		if actual := octreeIndices(objects, tree.InSphere(s.Center, s.Radius)); !IndexSetsEqual(expected, actual) {
+			t.Fatalf("InSphere %v failed. Expected %v objects, got %v", i, len(expected), len(actual))
+		}
+	}
+
+	cameras := []*Camera{
+		NewCamera(Cartesian{-20, 50, 50}, AxisX.Spherical, AxisZ.Spherical, rad(1, 4), 1.5, 1, 80),
+		NewCamera(Cartesian{50, 50, 50}, OctantNXNYNZ.Spherical, AxisZ.Spherical, rad(1, 3), 1, 0.5, 30),
+	}
+	for i, c := range cameras {
+		f := c.Frustum()
+		expected := []int{}
+		for j, o := range objects {
+			if f.IntersectsSphere(o.GetLocation(), radii[j]) != Outside {
+				expected = append(expected, j)
+			}
+		}
+		if actual := octreeIndices(objects, tree.InFrustum(f)); !IndexSetsEqual(expected, actual) {
+			t.Fatalf("InFrustum %v failed. Expected %v objects, got %v", i, len(expected), len(actual))
+		}
+	}
+
+	rays := []Ray{
+		NewRay(Cartesian{-20, 50, 50}, AxisX.Spherical),
+		NewRay(Cartesian{50, 50, 50}, OctantXYZ.Spherical),
+		NewRay(Cartesian{30, 30, 130}, AxisZN.Spherical),
+	}
+	for i, r := range rays {
+		expected := []int{}
+		for j, o := range objects {
+			if _, ok := r.IntersectSphere(o.GetLocation(), radii[j]); ok {
+				expected = append(expected, j)
+			}
+		}
+		hits := tree.IntersectRay(r)
+		actual := make([]*Object, len(hits))
+		for j, h := range hits {
+			actual[j] = h.Object
+			if j > 0 && hits[j-1].Distance > h.Distance {
+				t.Fatalf("IntersectRay %v failed. Hits were out of order", i)
+			}
+		}
+		if !IndexSetsEqual(expected, octreeIndices(objects, actual)) {
+			t.Fatalf("IntersectRay %v failed. Expected %v objects, got %v", i, len(expected), len(actual))
+		}
+	}
+}
+
+func TestOctreeInsert(t *testing.T) {
+	objects, radii := randomOctreeObjects(1000, 1)
+	tree := NewOctree(NewAABB(Cartesian{0, 0, 0}, Cartesian{100, 100, 100}))
+	for i, o := range objects {
+		tree.Insert(o, radii[i])
+	}
+	if tree.Len() != len(objects) {
+		t.Fatalf("Insert failed. Expected %v objects, got %v", len(objects), tree.Len())
+	}
+	if tree.root.children == nil {
+		t.Fatalf("Insert failed. Full tree was not split")
+	}
+	checkOctree(t, tree)
+	checkOctreeQueries(t, tree, objects, radii)
+
+	// Inserting again changes the radius
+	radii[0] = 40
+	tree.Insert(objects[0], radii[0])
+	if tree.Len() != len(objects) {
+		t.Fatalf("Insert failed. Reinserted object was added twice")
+	}
+	checkOctree(t, tree)
+	checkOctreeQueries(t, tree, objects, radii)
+}
+
+func TestOctreeUpdate(t *testing.T) {
+	objects, radii := randomOctreeObjects(1000, 2)
+	tree := NewOctree(NewAABB(Cartesian{0, 0, 0}, Cartesian{100, 100, 100}))
+	for i, o := range objects {
+		tree.Insert(o, radii[i])
+	}
+
+	// Move every other object a little, and a few a long way
+	r := rand.New(rand.NewSource(3))
+	for i, o := range objects {
+		if i%2 == 0 {
+			continue
+		}
+		offset := Cartesian{r.Float64() - 0.5, r.Float64() - 0.5, r.Float64() - 0.5}
+		if i%10 == 1 {
+			offset = offset.Scale(150).Cartesian()
+		}
+		o.SetLocation(o.GetLocation().Translate(offset).Cartesian())
+		if !tree.Update(o) {
+			t.Fatalf("Update %v failed. Object was not found", i)
+		}
+	}
+	checkOctree(t, tree)
+	checkOctreeQueries(t, tree, objects, radii)
+
+	// Move them all back to one corner
+	for _, o := range objects {
+		o.SetLocation(o.GetLocation().Scale(0.1).Cartesian())
+	}
+	tree.UpdateAll()
+	checkOctree(t, tree)
+	checkOctreeQueries(t, tree, objects, radii)
+
+	if tree.Update(NewObject(Cartesian{}, AxisZ.Spherical, AxisY.Spherical)) {
+		t.Fatalf("Update failed. Unknown object was updated")
+	}
+}
+
+func TestOctreeRemove(t *testing.T) {
+	objects, radii := randomOctreeObjects(500, 4)
+	tree := NewOctree(NewAABB(Cartesian{0, 0, 0}, Cartesian{100, 100, 100}))
+	for i, o := range objects {
+		tree.Insert(o, radii[i])
+	}
+	for i := 0; i < len(objects); i += 2 {
+		if !tree.Remove(objects[i]) {
+			t.Fatalf("Remove %v failed. Object was not found", i)
+		}
+	}
+	if tree.Remove(objects[0]) {
+		t.Fatalf("Remove failed. Removed object was found again")
+	}
+	remaining, remainingRadii := []*Object{}, []float64{}
+	for i := 1; i < len(objects); i += 2 {
+		remaining = append(remaining, objects[i])
+		remainingRadii = append(remainingRadii, radii[i])
+	}
+	checkOctree(t, tree)
+	checkOctreeQueries(t, tree, remaining, remainingRadii)
+
+	for _, o := range remaining {
+		tree.Remove(o)
+	}
+	if tree.Len() != 0 || tree.root.children != nil || len(tree.root.entries) != 0 {
+		t.Fatalf("Remove failed. Empty tree was not collapsed")
+	}
+}
+
+var benchmarkObjects []*Object
+
+func BenchmarkOctreeInSphere(b *testing.B) {
+	objects, radii := randomOctreeObjects(10000, 5)
+	tree := NewOctree(NewAABB(Cartesian{0, 0, 0}, Cartesian{100, 100, 100}))
+	for i, o := range objects {
+		tree.Insert(o, radii[i])
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchmarkObjects = tree.InSphere(Cartesian{50, 50, 50}, 10)
+	}
+}
+
+func BenchmarkOctreeUpdate(b *testing.B) {
+	objects, radii := randomOctreeObjects(10000, 6)
+	tree := NewOctree(NewAABB(Cartesian{0, 0, 0}, Cartesian{100, 100, 100}))
+	for i, o := range objects {
+		tree.Insert(o, radii[i])
+	}
+	step := Cartesian{0.1, 0, 0}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		o := objects[i%len(objects)]
+		o.SetLocation(o.GetLocation().Translate(step).Cartesian())
+		tree.Update(o)
+	}
+}
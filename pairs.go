@@ -7,8 +7,9 @@ import (
 const MinErr = 0.000001
 
 type vectorEquivalency struct {
-	Cartesian Cartesian
-	Spherical Spherical
+	Cartesian   Cartesian
+	Spherical   Spherical
+	Cylindrical Cylindrical
 }
 
 func rad(n, d int) float64 {
@@ -70,6 +71,11 @@ var (
 			T: 0,
 			P: 0,
 		},
+		Cylindrical: Cylindrical{
+			R: 0,
+			T: 0,
+			Z: 0,
+		},
 	}
 )
 
@@ -86,6 +92,11 @@ var (
 			T: 0,
 			P: rad(1, 2),
 		},
+		Cylindrical: Cylindrical{
+			R: 1,
+			T: 0,
+			Z: 0,
+		},
 	}
 
 	AxisXN = vectorEquivalency{
@@ -99,6 +110,11 @@ var (
 			T: rad(1, 1),
 			P: rad(1, 2),
 		},
+		Cylindrical: Cylindrical{
+			R: 1,
+			T: rad(1, 1),
+			Z: 0,
+		},
 	}
 
 	AxisY = vectorEquivalency{
@@ -112,6 +128,11 @@ var (
 			T: rad(1, 2),
 			P: rad(1, 2),
 		},
+		Cylindrical: Cylindrical{
+			R: 1,
+			T: rad(1, 2),
+			Z: 0,
+		},
 	}
 
 	AxisYN = vectorEquivalency{
@@ -125,6 +146,11 @@ var (
 			T: rad(3, 2),
 			P: rad(1, 2),
 		},
+		Cylindrical: Cylindrical{
+			R: 1,
+			T: rad(3, 2),
+			Z: 0,
+		},
 	}
 
 	AxisZ = vectorEquivalency{
@@ -138,6 +164,11 @@ var (
 			T: 0,
 			P: 0,
 		},
+		Cylindrical: Cylindrical{
+			R: 0,
+			T: 0,
+			Z: 1,
+		},
 	}
 
 	AxisZN = vectorEquivalency{
@@ -151,6 +182,11 @@ var (
 			T: 0,
 			P: rad(1, 1),
 		},
+		Cylindrical: Cylindrical{
+			R: 0,
+			T: 0,
+			Z: -1,
+		},
 	}
 )
 
@@ -167,6 +203,11 @@ var (
 			T: 0,
 			P: rad(1, 2),
 		},
+		Cylindrical: Cylindrical{
+			R: 3,
+			T: 0,
+			Z: 0,
+		},
 	}
 
 	AxisXN3 = vectorEquivalency{
@@ -180,6 +221,11 @@ var (
 			T: rad(1, 1),
 			P: rad(1, 2),
 		},
+		Cylindrical: Cylindrical{
+			R: 3,
+			T: rad(1, 1),
+			Z: 0,
+		},
 	}
 
 	AxisY3 = vectorEquivalency{
@@ -193,6 +239,11 @@ var (
 			T: rad(1, 2),
 			P: rad(1, 2),
 		},
+		Cylindrical: Cylindrical{
+			R: 3,
+			T: rad(1, 2),
+			Z: 0,
+		},
 	}
 
 	AxisYN3 = vectorEquivalency{
@@ -206,6 +257,11 @@ var (
 			T: rad(3, 2),
 			P: rad(1, 2),
 		},
+		Cylindrical: Cylindrical{
+			R: 3,
+			T: rad(3, 2),
+			Z: 0,
+		},
 	}
 
 	AxisZ3 = vectorEquivalency{
@@ -219,6 +275,11 @@ var (
 			T: 0,
 			P: 0,
 		},
+		Cylindrical: Cylindrical{
+			R: 0,
+			T: 0,
+			Z: 3,
+		},
 	}
 
 	AxisZN3 = vectorEquivalency{
@@ -232,6 +293,11 @@ var (
 			T: 0,
 			P: rad(1, 1),
 		},
+		Cylindrical: Cylindrical{
+			R: 0,
+			T: 0,
+			Z: -3,
+		},
 	}
 )
 
@@ -248,6 +314,11 @@ var (
 			T: rad(1, 4),
 			P: 0.304086724 * math.Pi,
 		},
+		Cylindrical: Cylindrical{
+			R: 0.8164965809,
+			T: rad(1, 4),
+			Z: 0.5773502669,
+		},
 	}
 	OctantNXYZ = vectorEquivalency{
 		Cartesian: Cartesian{
@@ -260,6 +331,11 @@ var (
 			T: rad(3, 4),
 			P: 0.304086724 * math.Pi,
 		},
+		Cylindrical: Cylindrical{
+			R: 0.8164965809,
+			T: rad(3, 4),
+			Z: 0.5773502669,
+		},
 	}
 	OctantNXNYZ = vectorEquivalency{
 		Cartesian: Cartesian{
@@ -272,6 +348,11 @@ var (
 			T: rad(5, 4),
 			P: 0.304086724 * math.Pi,
 		},
+		Cylindrical: Cylindrical{
+			R: 0.8164965809,
+			T: rad(5, 4),
+			Z: 0.5773502669,
+		},
 	}
 	OctantXNYZ = vectorEquivalency{
 		Cartesian: Cartesian{
@@ -284,6 +365,11 @@ var (
 			T: rad(7, 4),
 			P: 0.304086724 * math.Pi,
 		},
+		Cylindrical: Cylindrical{
+			R: 0.8164965809,
+			T: rad(7, 4),
+			Z: 0.5773502669,
+		},
 	}
 	OctantXYNZ = vectorEquivalency{
 		Cartesian: Cartesian{
@@ -296,6 +382,11 @@ var (
 			T: rad(1, 4),
 			P: 0.695913276 * math.Pi,
 		},
+		Cylindrical: Cylindrical{
+			R: 0.8164965809,
+			T: rad(1, 4),
+			Z: -0.5773502669,
+		},
 	}
 	OctantNXYNZ = vectorEquivalency{
 		Cartesian: Cartesian{
@@ -308,6 +399,11 @@ var (
 			T: rad(3, 4),
 			P: 0.695913276 * math.Pi,
 		},
+		Cylindrical: Cylindrical{
+			R: 0.8164965809,
+			T: rad(3, 4),
+			Z: -0.5773502669,
+		},
 	}
 	OctantNXNYNZ = vectorEquivalency{
 		Cartesian: Cartesian{
@@ -320,6 +416,11 @@ var (
 			T: rad(5, 4),
 			P: 0.695913276 * math.Pi,
 		},
+		Cylindrical: Cylindrical{
+			R: 0.8164965809,
+			T: rad(5, 4),
+			Z: -0.5773502669,
+		},
 	}
 	OctantXNYNZ = vectorEquivalency{
 		Cartesian: Cartesian{
@@ -332,6 +433,11 @@ var (
 			T: rad(7, 4),
 			P: 0.695913276 * math.Pi,
 		},
+		Cylindrical: Cylindrical{
+			R: 0.8164965809,
+			T: rad(7, 4),
+			Z: -0.5773502669,
+		},
 	}
 )
 
@@ -348,6 +454,11 @@ var (
 			T: rad(1, 4),
 			P: 0.304086724 * math.Pi,
 		},
+		Cylindrical: Cylindrical{
+			R: 2.4494897428,
+			T: rad(1, 4),
+			Z: 1.7320508007,
+		},
 	}
 	OctantNXYZ3 = vectorEquivalency{
 		Cartesian: Cartesian{
@@ -360,6 +471,11 @@ var (
 			T: rad(3, 4),
 			P: 0.304086724 * math.Pi,
 		},
+		Cylindrical: Cylindrical{
+			R: 2.4494897428,
+			T: rad(3, 4),
+			Z: 1.7320508007,
+		},
 	}
 	OctantNXNYZ3 = vectorEquivalency{
 		Cartesian: Cartesian{
@@ -372,6 +488,11 @@ var (
 			T: rad(5, 4),
 			P: 0.304086724 * math.Pi,
 		},
+		Cylindrical: Cylindrical{
+			R: 2.4494897428,
+			T: rad(5, 4),
+			Z: 1.7320508007,
+		},
 	}
 	OctantXNYZ3 = vectorEquivalency{
 		Cartesian: Cartesian{
@@ -384,6 +505,11 @@ var (
 			T: rad(7, 4),
 			P: 0.304086724 * math.Pi,
 		},
+		Cylindrical: Cylindrical{
+			R: 2.4494897428,
+			T: rad(7, 4),
+			Z: 1.7320508007,
+		},
 	}
 	OctantXYNZ3 = vectorEquivalency{
 		Cartesian: Cartesian{
@@ -396,6 +522,11 @@ var (
 			T: rad(1, 4),
 			P: 0.695913276 * math.Pi,
 		},
+		Cylindrical: Cylindrical{
+			R: 2.4494897428,
+			T: rad(1, 4),
+			Z: -1.7320508007,
+		},
 	}
 	OctantNXYNZ3 = vectorEquivalency{
 		Cartesian: Cartesian{
@@ -408,6 +539,11 @@ var (
 			T: rad(3, 4),
 			P: 0.695913276 * math.Pi,
 		},
+		Cylindrical: Cylindrical{
+			R: 2.4494897428,
+			T: rad(3, 4),
+			Z: -1.7320508007,
+		},
 	}
 	OctantNXNYNZ3 = vectorEquivalency{
 		Cartesian: Cartesian{
@@ -420,6 +556,11 @@ var (
 			T: rad(5, 4),
 			P: 0.695913276 * math.Pi,
 		},
+		Cylindrical: Cylindrical{
+			R: 2.4494897428,
+			T: rad(5, 4),
+			Z: -1.7320508007,
+		},
 	}
 	OctantXNYNZ3 = vectorEquivalency{
 		Cartesian: Cartesian{
@@ -432,5 +573,10 @@ var (
 			T: rad(7, 4),
 			P: 0.695913276 * math.Pi,
 		},
+		Cylindrical: Cylindrical{
+			R: 2.4494897428,
+			T: rad(7, 4),
+			Z: -1.7320508007,
+		},
 	}
 )
@@ -0,0 +1,125 @@
+package space
+
+import (
+	"fmt"
+	"math"
+)
+
+// Plane is a flat surface which extends forever
+// Every point v on the plane satisfies Normal · v = Offset
+type Plane struct {
+	// Normal is perpendicular to the plane and has a length of one
+	// Normal points towards the front of the plane
+	Normal Cartesian
+	// Offset is the signed distance from the origin to the plane, along Normal
+	Offset float64
+}
+
+// NewPlane creates a new Plane from a normal and offset
+func NewPlane(normal Vector, offset float64) Plane {
+	return Plane{
+		Normal: normal.Normalize().Cartesian(),
+		Offset: offset,
+	}
+}
+
+// NewPlaneFromPoint creates a new Plane which passes through point
+func NewPlaneFromPoint(point, normal Vector) Plane {
+	n := normal.Normalize().Cartesian()
+	return Plane{
+		Normal: n,
+		Offset: n.Dot(point),
+	}
+}
+
+// NewPlaneFromPoints creates a new Plane which passes through a, b and c
+// The front of the plane is the side from which a, b and c appear counter-clockwise.
+// If a, b and c are on a line (or any of them coincide), there is no single plane and ok is false.
+func NewPlaneFromPoints(a, b, c Vector) (p Plane, ok bool) {
+	ab := b.Subtract(a)
+	ac := c.Subtract(a)
+	normal := ab.Cross(ac)
+	if normal.Length() <= MinErr*ab.Length()*ac.Length() {
+		return Plane{}, false
+	}
+	return NewPlaneFromPoint(a, normal), true
+}
+
+// SignedDistance returns the distance from p to v
+// The distance is positive in front of p and negative behind it
+func (p Plane) SignedDistance(v Vector) float64 {
+	return p.Normal.Dot(v) - p.Offset
+}
+
+// Side returns 1 if v is in front of p, -1 if v is behind p and 0 if v is on p
+func (p Plane) Side(v Vector) int {
+	d := p.SignedDistance(v)
+	switch {
+	case near(d, 0):
+		return 0
+	case d > 0:
+		return 1
+	default:
+		return -1
+	}
+}
+
+// ProjectPoint returns the point on p which is closest to v
+func (p Plane) ProjectPoint(v Vector) Cartesian {
+	d := p.SignedDistance(v)
+	return v.Subtract(p.Normal.Scale(d)).Cartesian()
+}
+
+// Reflect returns the mirror image of the point v across p
+func (p Plane) Reflect(v Vector) Cartesian {
+	d := p.SignedDistance(v)
+	return v.Subtract(p.Normal.Scale(2 * d)).Cartesian()
+}
+
+// ReflectionMatrix produces a matrix which will reflect across p
+func (p Plane) ReflectionMatrix() Matrix {
+	n := p.Normal
+	d := p.Offset
+	return Matrix{
+		{1 - 2*n.X*n.X, -2 * n.X * n.Y, -2 * n.X * n.Z, 2 * d * n.X},
+		{-2 * n.Y * n.X, 1 - 2*n.Y*n.Y, -2 * n.Y * n.Z, 2 * d * n.Y},
+		{-2 * n.Z * n.X, -2 * n.Z * n.Y, 1 - 2*n.Z*n.Z, 2 * d * n.Z},
+		{0, 0, 0, 1},
+	}
+}
+
+// IntersectLine finds where the line through point, in direction, meets p
+// If the line is parallel to p, ok will be false
+func (p Plane) IntersectLine(point, direction Vector) (intersection Cartesian, ok bool) {
+	denom := p.Normal.Dot(direction.Normalize())
+	if near(denom, 0) {
+		return Cartesian{}, false
+	}
+	t := -p.SignedDistance(point) / p.Normal.Dot(direction)
+	return point.Translate(direction.Scale(t)).Cartesian(), true
+}
+
+// IntersectRay finds where r meets p
+// The normal of the Hit faces the origin of r.
+func (p Plane) IntersectRay(r Ray) (Hit, bool) {
+	return r.IntersectPlane(p.Normal.Scale(p.Offset).Cartesian(), p.Normal)
+}
+
+// IntersectPlane finds the line where p and q meet
+// The line passes through point and extends along direction (of length one).
+// If the planes are parallel, ok will be false
+func (p Plane) IntersectPlane(q Plane) (point, direction Cartesian, ok bool) {
+	cross := p.Normal.Cross(q.Normal).Cartesian()
+	length2 := cross.LengthSquared()
+	if near(math.Sqrt(length2), 0) {
+		return Cartesian{}, Cartesian{}, false
+	}
+	a := q.Normal.Cross(cross).Scale(p.Offset)
+	b := cross.Cross(p.Normal).Scale(q.Offset)
+	point = a.Translate(b).Scale(1 / length2).Cartesian()
+	return point, cross.Normalize().Cartesian(), true
+}
+
+func (p Plane) String() string {
+	return fmt.Sprintf("{Normal:%v, Offset:%4.2f}", p.Normal, p.Offset)
+}
@@ -0,0 +1,284 @@
+package space
+
+import (
+	"testing"
+)
+
+// PlanesEqual compares Planes
+func PlanesEqual(a, b Plane) bool {
+	return CartesiansEqual(a.Normal, b.Normal) && near(a.Offset, b.Offset)
+}
+
+// planeFromPoints is NewPlaneFromPoints for points which are known to form a plane
+func planeFromPoints(a, b, c Vector) Plane {
+	p, _ := NewPlaneFromPoints(a, b, c)
+	return p
+}
+
+func TestNewPlane(t *testing.T) {
+	cases := []struct {
+		Plane    Plane
+		Expected Plane
+	}{
+		{
+			Plane:    NewPlane(AxisZ3.Spherical, 2),
+			Expected: Plane{Normal: Cartesian{0, 0, 1}, Offset: 2},
+		},
+		{
+			Plane:    NewPlaneFromPoint(Cartesian{5, 7, 2}, AxisZ3.Cartesian),
+			Expected: Plane{Normal: Cartesian{0, 0, 1}, Offset: 2},
+		},
+		{
+			Plane:    NewPlaneFromPoint(Cartesian{-3, 7, 2}, AxisX.Cartesian),
+			Expected: Plane{Normal: Cartesian{1, 0, 0}, Offset: -3},
+		},
+		{
+			Plane:    planeFromPoints(Cartesian{0, 0, 2}, Cartesian{1, 0, 2}, Cartesian{0, 1, 2}),
+			Expected: Plane{Normal: Cartesian{0, 0, 1}, Offset: 2},
+		},
+		{
+			Plane:    planeFromPoints(Cartesian{0, 0, 2}, Cartesian{0, 1, 2}, Cartesian{1, 0, 2}),
+			Expected: Plane{Normal: Cartesian{0, 0, -1}, Offset: -2},
+		},
+		{
+			Plane:    planeFromPoints(AxisX.Cartesian, AxisY.Spherical, AxisZ.Cartesian),
+			Expected: Plane{Normal: OctantXYZ.Cartesian, Offset: OctantXYZ.Cartesian.X},
+		},
+		{
+			// A small triangle still has a normal of length one
+			Plane:    planeFromPoints(Cartesian{0, 0, 2}, Cartesian{1e-4, 0, 2}, Cartesian{0, 1e-4, 2}),
+			Expected: Plane{Normal: Cartesian{0, 0, 1}, Offset: 2},
+		},
+	}
+	for i, c := range cases {
+		if !PlanesEqual(c.Expected, c.Plane) {
+			t.Fatalf("NewPlane %v failed. Planes were not equal:\n\tExpected: %v,\n\tActual: %v", i, c.Expected, c.Plane)
+		}
+	}
+}
+
+func TestNewPlaneFromPointsDegenerate(t *testing.T) {
+	cases := []struct {
+		A, B, C Cartesian
+		OK      bool
+	}{
+		{Cartesian{0, 0, 0}, Cartesian{1, 0, 0}, Cartesian{0, 1, 0}, true},
+		{Cartesian{0, 0, 0}, Cartesian{1e-4, 0, 0}, Cartesian{0, 1e-4, 0}, true},
+		// On a line
+		{Cartesian{0, 0, 0}, Cartesian{1, 1, 1}, Cartesian{3, 3, 3}, false},
+		{Cartesian{0, 0, 0}, Cartesian{1e-4, 1e-4, 0}, Cartesian{-2e-4, -2e-4, 0}, false},
+		// Coincident
+		{Cartesian{1, 2, 3}, Cartesian{1, 2, 3}, Cartesian{0, 1, 0}, false},
+		{Cartesian{1, 2, 3}, Cartesian{1, 2, 3}, Cartesian{1, 2, 3}, false},
+	}
+	for i, c := range cases {
+		p, ok := NewPlaneFromPoints(c.A, c.B, c.C)
+		if ok != c.OK {
+			t.Fatalf("NewPlaneFromPoints %v failed:\n\tExpected ok: %v,\n\tActual ok: %v", i, c.OK, ok)
+		}
+		if ok && !near(p.Normal.Length(), 1) {
+			t.Fatalf("NewPlaneFromPoints %v failed. Normal was %v", i, p.Normal)
+		}
+	}
+}
+
+func TestPlaneSignedDistance(t *testing.T) {
+	p := NewPlane(AxisZ.Cartesian, 2)
+	cases := []struct {
+		Vector   Vector
+		Distance float64
+		Side     int
+	}{
+		{Cartesian{0, 0, 2}, 0, 0},
+		{Cartesian{5, -7, 2}, 0, 0},
+		{AxisZ3.Spherical, 1, 1},
+		{Origin.Cartesian, -2, -1},
+		{AxisZN3.Cartesian, -5, -1},
+	}
+	for i, c := range cases {
+		if actual := p.SignedDistance(c.Vector); !near(c.Distance, actual) {
+			t.Fatalf("SignedDistance %v failed:\n\tExpected: %v,\n\tActual: %v", i, c.Distance, actual)
+		}
+		if actual := p.Side(c.Vector); c.Side != actual {
+			t.Fatalf("Side %v failed:\n\tExpected: %v,\n\tActual: %v", i, c.Side, actual)
+		}
+	}
+}
+
+func TestPlaneProjectPoint(t *testing.T) {
+	cases := []CartesianTest{
+		{
+			Initial: Cartesian{2, 3, 5},
+			Operation: func(v Cartesian) Cartesian {
+				return NewPlane(AxisZ.Cartesian, 0).ProjectPoint(v)
+			},
+			Expected: Cartesian{2, 3, 0},
+		},
+		{
+			Initial: Cartesian{2, 3, 5},
+			Operation: func(v Cartesian) Cartesian {
+				return NewPlane(AxisXN.Cartesian, 1).ProjectPoint(v)
+			},
+			Expected: Cartesian{-1, 3, 5},
+		},
+		{
+			// Projecting onto a plane through the origin keeps the orthogonal portion
+			Initial: OctantXYZ3.Cartesian,
+			Operation: func(v Cartesian) Cartesian {
+				return NewPlane(AxisX.Spherical, 0).ProjectPoint(v)
+			},
+			Expected: AxisX.Spherical.PortionOrtagonal(OctantXYZ3.Spherical).Cartesian(),
+		},
+	}
+	RunCartesianTests(t, cases)
+}
+
+func TestPlaneReflect(t *testing.T) {
+	cases := []CartesianTest{
+		{
+			Initial: Cartesian{2, 3, 5},
+			Operation: func(v Cartesian) Cartesian {
+				return NewPlane(AxisZ.Cartesian, 0).Reflect(v)
+			},
+			Expected: Cartesian{2, 3, -5},
+		},
+		{
+			Initial: Cartesian{2, 3, 5},
+			Operation: func(v Cartesian) Cartesian {
+				return NewPlane(AxisY.Cartesian, 1).Reflect(v)
+			},
+			Expected: Cartesian{2, -1, 5},
+		},
+		{
+			Initial: Cartesian{2, 3, 5},
+			Operation: func(v Cartesian) Cartesian {
+				m := NewPlane(AxisY.Cartesian, 1).ReflectionMatrix()
+				return v.Transform(m).Cartesian()
+			},
+			Expected: Cartesian{2, -1, 5},
+		},
+		{
+			Initial: AxisX.Cartesian,
+			Operation: func(v Cartesian) Cartesian {
+				p := NewPlaneFromPoint(Origin.Cartesian, Cartesian{1, -1, 0})
+				return v.Transform(p.ReflectionMatrix()).Cartesian()
+			},
+			Expected: AxisY.Cartesian,
+		},
+	}
+	RunCartesianTests(t, cases)
+}
+
+func TestPlaneIntersectLine(t *testing.T) {
+	cases := []struct {
+		Plane     Plane
+		Point     Vector
+		Direction Vector
+		Expected  Cartesian
+		OK        bool
+	}{
+		{
+			Plane:     NewPlane(AxisZ.Cartesian, 2),
+			Point:     Cartesian{1, 1, 0},
+			Direction: AxisZ3.Spherical,
+			Expected:  Cartesian{1, 1, 2},
+			OK:        true,
+		},
+		{
+			// Lines extend in both directions
+			Plane:     NewPlane(AxisZ.Cartesian, 2),
+			Point:     Cartesian{1, 1, 0},
+			Direction: AxisZN.Cartesian,
+			Expected:  Cartesian{1, 1, 2},
+			OK:        true,
+		},
+		{
+			Plane:     NewPlane(AxisX.Cartesian, 1),
+			Point:     Origin.Cartesian,
+			Direction: Cartesian{2, 1, 0},
+			Expected:  Cartesian{1, 0.5, 0},
+			OK:        true,
+		},
+		{
+			Plane:     NewPlane(AxisZ.Cartesian, 2),
+			Point:     Cartesian{1, 1, 0},
+			Direction: AxisX.Cartesian,
+			OK:        false,
+		},
+	}
+	for i, c := range cases {
+		actual, ok := c.Plane.IntersectLine(c.Point, c.Direction)
+		if ok != c.OK {
+			t.Fatalf("IntersectLine %v failed:\n\tExpected ok: %v,\n\tActual ok: %v", i, c.OK, ok)
+		}
+		if ok && !CartesiansEqual(c.Expected, actual) {
+			t.Fatalf("IntersectLine %v failed. Cartesians were not equal:\n\tExpected: %v,\n\tActual: %v", i, c.Expected, actual)
+		}
+	}
+}
+
+func TestPlaneIntersectRay(t *testing.T) {
+	cases := []HitTest{
+		{
+			Ray: NewRay(Cartesian{1, 1, 0}, AxisZ.Spherical),
+			Operation: func(r Ray) (Hit, bool) {
+				return NewPlane(AxisZ.Cartesian, 2).IntersectRay(r)
+			},
+			Expected: Hit{Distance: 2, Point: Cartesian{1, 1, 2}, Normal: Cartesian{0, 0, -1}},
+			OK:       true,
+		},
+		{
+			Ray: NewRay(Cartesian{1, 1, 0}, AxisZN.Spherical),
+			Operation: func(r Ray) (Hit, bool) {
+				return NewPlane(AxisZ.Cartesian, 2).IntersectRay(r)
+			},
+			OK: false,
+		},
+	}
+	RunHitTests(t, cases)
+}
+
+func TestPlaneIntersectPlane(t *testing.T) {
+	cases := []struct {
+		A, B      Plane
+		Direction Cartesian
+		OK        bool
+	}{
+		{
+			A:         NewPlane(AxisX.Cartesian, 1),
+			B:         NewPlane(AxisY.Cartesian, 2),
+			Direction: Cartesian{0, 0, 1},
+			OK:        true,
+		},
+		{
+			A:         NewPlane(AxisZ.Cartesian, -3),
+			B:         NewPlaneFromPoint(Cartesian{5, 5, 5}, OctantXYZ.Spherical),
+			Direction: Cartesian{-sqrt2o2, sqrt2o2, 0},
+			OK:        true,
+		},
+		{
+			A:  NewPlane(AxisX.Cartesian, 1),
+			B:  NewPlane(AxisXN.Cartesian, 2),
+			OK: false,
+		},
+	}
+	for i, c := range cases {
+		point, direction, ok := c.A.IntersectPlane(c.B)
+		if ok != c.OK {
+			t.Fatalf("IntersectPlane %v failed:\n\tExpected ok: %v,\n\tActual ok: %v", i, c.OK, ok)
+		}
+		if !ok {
+			continue
+		}
+		if !CartesiansEqual(c.Direction, direction) {
+			t.Fatalf("IntersectPlane %v failed. Directions were not equal:\n\tExpected: %v,\n\tActual: %v", i, c.Direction, direction)
+		}
+		if c.A.Side(point) != 0 || c.B.Side(point) != 0 {
+			t.Fatalf("IntersectPlane %v failed. Point %v was not on both planes", i, point)
+		}
+		further := point.Translate(direction.Scale(3))
+		if c.A.Side(further) != 0 || c.B.Side(further) != 0 {
+			t.Fatalf("IntersectPlane %v failed. Line did not follow both planes", i)
+		}
+	}
+}
@@ -0,0 +1,248 @@
+package space
+
+import (
+	"fmt"
+	"math"
+)
+
+// Quaternion represents a rotation in 3D space
+// Quaternions compose, invert and interpolate without the gimbal issues of
+// chained rotation matricies
+type Quaternion struct {
+	// W is the scalar part
+	W float64
+	// X, Y, and Z are the vector part
+	X, Y, Z float64
+}
+
+// NewQuaternion creates a new Quaternion from its parts
+func NewQuaternion(w, x, y, z float64) Quaternion {
+	return Quaternion{
+		W: w,
+		X: x,
+		Y: y,
+		Z: z,
+	}
+}
+
+// NewIdentityQuaternion creates a Quaternion which does not rotate
+func NewIdentityQuaternion() Quaternion {
+	return Quaternion{W: 1}
+}
+
+// NewAxisAngleQuaternion creates a Quaternion which rotates by angle about axis
+// If axis has no length, the identity Quaternion is returned
+func NewAxisAngleQuaternion(axis Vector, angle float64) Quaternion {
+	a := axis.Cartesian()
+	length := a.Length()
+	if length == 0 {
+		return NewIdentityQuaternion()
+	}
+	sin, cos := math.Sincos(angle / 2)
+	s := sin / length
+	return Quaternion{
+		W: cos,
+		X: a.X * s,
+		Y: a.Y * s,
+		Z: a.Z * s,
+	}
+}
+
+// Quaternion returns the rotation described by s as a Quaternion
+// The rotation is equivalent to s.RotationMatrix(), turning Z towards s
+func (s Spherical) Quaternion() Quaternion {
+	// Rz(T) * Ry(P) * Rz(-T) is a rotation by P about the Y axis rotated by T
+	sinT, cosT := math.Sincos(s.T)
+	sinP, cosP := math.Sincos(s.P / 2)
+	return Quaternion{
+		W: cosP,
+		X: -sinT * sinP,
+		Y: cosT * sinP,
+		Z: 0,
+	}
+}
+
+// Quaternion returns the rotation described by the upper 3x3 of m as a Quaternion
+// m is expected to be a pure rotation (orthonormal) in its upper 3x3
+func (m Matrix) Quaternion() Quaternion {
+	trace := m[0][0] + m[1][1] + m[2][2]
+	var q Quaternion
+	switch {
+	case trace > 0:
+		s := math.Sqrt(trace+1) * 2
+		q = Quaternion{
+			W: s / 4,
+			X: (m[2][1] - m[1][2]) / s,
+			Y: (m[0][2] - m[2][0]) / s,
+			Z: (m[1][0] - m[0][1]) / s,
+		}
+	case m[0][0] > m[1][1] && m[0][0] > m[2][2]:
+		s := math.Sqrt(1+m[0][0]-m[1][1]-m[2][2]) * 2
+		q = Quaternion{
+			W: (m[2][1] - m[1][2]) / s,
+			X: s / 4,
+			Y: (m[0][1] + m[1][0]) / s,
+			Z: (m[0][2] + m[2][0]) / s,
+		}
+	case m[1][1] > m[2][2]:
+		s := math.Sqrt(1+m[1][1]-m[0][0]-m[2][2]) * 2
+		q = Quaternion{
+			W: (m[0][2] - m[2][0]) / s,
+			X: (m[0][1] + m[1][0]) / s,
+			Y: s / 4,
+			Z: (m[1][2] + m[2][1]) / s,
+		}
+	default:
+		s := math.Sqrt(1+m[2][2]-m[0][0]-m[1][1]) * 2
+		q = Quaternion{
+			W: (m[1][0] - m[0][1]) / s,
+			X: (m[0][2] + m[2][0]) / s,
+			Y: (m[1][2] + m[2][1]) / s,
+			Z: s / 4,
+		}
+	}
+	return q.Normalize()
+}
+
+// RotationMatrix produces a matrix which will rotate by q
+func (q Quaternion) RotationMatrix() Matrix {
+	q = q.Normalize()
+	xx, yy, zz := q.X*q.X, q.Y*q.Y, q.Z*q.Z
+	xy, xz, yz := q.X*q.Y, q.X*q.Z, q.Y*q.Z
+	wx, wy, wz := q.W*q.X, q.W*q.Y, q.W*q.Z
+	return Matrix{
+		{1 - 2*(yy+zz), 2 * (xy - wz), 2 * (xz + wy), 0},
+		{2 * (xy + wz), 1 - 2*(xx+zz), 2 * (yz - wx), 0},
+		{2 * (xz - wy), 2 * (yz + wx), 1 - 2*(xx+yy), 0},
+		{0, 0, 0, 1},
+	}
+}
+
+// AxisAngle returns the axis (of length one) and the angle of the rotation described by q
+// If q does not rotate, the Z axis is returned with an angle of zero
+func (q Quaternion) AxisAngle() (Cartesian, float64) {
+	q = q.Normalize()
+	if q.W < 0 {
+		q = q.Scale(-1)
+	}
+	sin := math.Sqrt((q.X * q.X) + (q.Y * q.Y) + (q.Z * q.Z))
+	if near(sin, 0) {
+		return Cartesian{0, 0, 1}, 0
+	}
+	angle := 2 * math.Atan2(sin, q.W)
+	return Cartesian{
+		X: q.X / sin,
+		Y: q.Y / sin,
+		Z: q.Z / sin,
+	}, angle
+}
+
+// Multiply will return the result of q * r
+// The resulting rotation is equivalent to rotating by r and then by q
+func (q Quaternion) Multiply(r Quaternion) Quaternion {
+	return Quaternion{
+		W: (q.W * r.W) - (q.X * r.X) - (q.Y * r.Y) - (q.Z * r.Z),
+		X: (q.W * r.X) + (q.X * r.W) + (q.Y * r.Z) - (q.Z * r.Y),
+		Y: (q.W * r.Y) - (q.X * r.Z) + (q.Y * r.W) + (q.Z * r.X),
+		Z: (q.W * r.Z) + (q.X * r.Y) - (q.Y * r.X) + (q.Z * r.W),
+	}
+}
+
+// Scale scales every part of q by i
+func (q Quaternion) Scale(i float64) Quaternion {
+	return Quaternion{
+		W: q.W * i,
+		X: q.X * i,
+		Y: q.Y * i,
+		Z: q.Z * i,
+	}
+}
+
+// Dot returns the dot product of q and r
+func (q Quaternion) Dot(r Quaternion) float64 {
+	return (q.W * r.W) + (q.X * r.X) + (q.Y * r.Y) + (q.Z * r.Z)
+}
+
+// Length returns the norm of q
+func (q Quaternion) Length() float64 {
+	return math.Sqrt(q.Dot(q))
+}
+
+// Normalize returns q scaled to a length of one
+// If q has no length, the identity Quaternion is returned
+func (q Quaternion) Normalize() Quaternion {
+	length := q.Length()
+	if near(length, 0) {
+		return NewIdentityQuaternion()
+	}
+	return q.Scale(1 / length)
+}
+
+// Conjugate returns q with its vector part negated
+func (q Quaternion) Conjugate() Quaternion {
+	return Quaternion{
+		W: q.W,
+		X: -q.X,
+		Y: -q.Y,
+		Z: -q.Z,
+	}
+}
+
+// Inverse returns the Quaternion which undoes q
+func (q Quaternion) Inverse() Quaternion {
+	length2 := q.Dot(q)
+	if near(length2, 0) {
+		return NewIdentityQuaternion()
+	}
+	return q.Conjugate().Scale(1 / length2)
+}
+
+// Slerp spherically interpolates between q (t = 0) and r (t = 1)
+// The shortest path between the two rotations is always taken
+func (q Quaternion) Slerp(r Quaternion, t float64) Quaternion {
+	q = q.Normalize()
+	r = r.Normalize()
+	cos := q.Dot(r)
+	if cos < 0 {
+		r = r.Scale(-1)
+		cos = -cos
+	}
+
+	// Fall back to linear interpolation when the rotations are nearly identical
+	if cos > 1-MinErr {
+		return Quaternion{
+			W: q.W + (r.W-q.W)*t,
+			X: q.X + (r.X-q.X)*t,
+			Y: q.Y + (r.Y-q.Y)*t,
+			Z: q.Z + (r.Z-q.Z)*t,
+		}.Normalize()
+	}
+
+	theta := math.Acos(cos)
+	sin := math.Sin(theta)
+	a := math.Sin((1-t)*theta) / sin
+	b := math.Sin(t*theta) / sin
+	return Quaternion{
+		W: (q.W * a) + (r.W * b),
+		X: (q.X * a) + (r.X * b),
+		Y: (q.Y * a) + (r.Y * b),
+		Z: (q.Z * a) + (r.Z * b),
+	}
+}
+
+// Rotate returns v rotated by q
+func (q Quaternion) Rotate(v Vector) Vector {
+	c := v.Cartesian()
+	q = q.Normalize()
+	p := Quaternion{X: c.X, Y: c.Y, Z: c.Z}
+	r := q.Multiply(p).Multiply(q.Conjugate())
+	return Cartesian{
+		X: r.X,
+		Y: r.Y,
+		Z: r.Z,
+	}
+}
+
+func (q Quaternion) String() string {
+	return fmt.Sprintf("{W:%4.2f, X:%4.2f, Y:%4.2f, Z:%4.2f}", q.W, q.X, q.Y, q.Z)
+}
@@ -0,0 +1,297 @@
+package space
+
+import (
+	"testing"
+)
+
+type QuaternionTest struct {
+	Initial   Quaternion
+	Operation func(Quaternion) Quaternion
+	Expected  Quaternion
+}
+
+func RunQuaternionTests(t *testing.T, cases []QuaternionTest) {
+	for i, c := range cases {
+		actual := c.Operation(c.Initial)
+		if !QuaternionsEqual(c.Expected, actual) {
+			t.Fatalf("Test %v failed. Quaternions were not equal:\n\tExpected: %v,\n\tActual: %v", i, c.Expected, actual)
+		}
+	}
+}
+
+// QuaternionsEqual compares Quaternions
+// q and -q describe the same rotation and are considered equal
+func QuaternionsEqual(a, b Quaternion) bool {
+	if near(a.W, b.W) && near(a.X, b.X) && near(a.Y, b.Y) && near(a.Z, b.Z) {
+		return true
+	}
+	if near(a.W, -b.W) && near(a.X, -b.X) && near(a.Y, -b.Y) && near(a.Z, -b.Z) {
+		return true
+	}
+	return false
+}
+
+func TestNewAxisAngleQuaternion(t *testing.T) {
+	cases := []CartesianTest{
+		{
+			Initial: AxisX.Cartesian,
+			Operation: func(v Cartesian) Cartesian {
+				q := NewAxisAngleQuaternion(AxisZ.Cartesian, rad(1, 2))
+				return q.Rotate(v).Cartesian()
+			},
+			Expected: AxisY.Cartesian,
+		},
+		{
+			Initial: AxisY.Cartesian,
+			Operation: func(v Cartesian) Cartesian {
+				q := NewAxisAngleQuaternion(AxisX3.Spherical, rad(1, 2))
+				return q.Rotate(v).Cartesian()
+			},
+			Expected: AxisZ.Cartesian,
+		},
+		{
+			Initial: AxisZ3.Cartesian,
+			Operation: func(v Cartesian) Cartesian {
+				q := NewAxisAngleQuaternion(AxisY.Cartesian, rad(1, 2))
+				return q.Rotate(v).Cartesian()
+			},
+			Expected: AxisX3.Cartesian,
+		},
+		{
+			Initial: AxisX.Cartesian,
+			Operation: func(v Cartesian) Cartesian {
+				q := NewAxisAngleQuaternion(OctantXYZ.Cartesian, rad(2, 3))
+				return q.Rotate(v).Cartesian()
+			},
+			Expected: AxisY.Cartesian,
+		},
+		{
+			Initial: Cartesian{2, 3, 5},
+			Operation: func(v Cartesian) Cartesian {
+				q := NewAxisAngleQuaternion(Origin.Cartesian, rad(1, 2))
+				return q.Rotate(v).Cartesian()
+			},
+			Expected: Cartesian{2, 3, 5},
+		},
+		{
+			// A short axis still has a direction
+			Initial: AxisX.Cartesian,
+			Operation: func(v Cartesian) Cartesian {
+				q := NewAxisAngleQuaternion(Cartesian{0, 0, 1e-7}, rad(1, 2))
+				return q.Rotate(v).Cartesian()
+			},
+			Expected: AxisY.Cartesian,
+		},
+	}
+	RunCartesianTests(t, cases)
+}
+
+func TestSphericalQuaternion(t *testing.T) {
+	vectors := []Cartesian{
+		AxisX.Cartesian,
+		AxisY.Cartesian,
+		AxisZ.Cartesian,
+		OctantXNYZ3.Cartesian,
+	}
+	cases := []CartesianTest{}
+	for _, pair := range AllEquivalencies {
+		s := pair.Spherical
+		for _, v := range vectors {
+			cases = append(cases, CartesianTest{
+				Initial: v,
+				Operation: func(v Cartesian) Cartesian {
+					return s.Quaternion().Rotate(v).Cartesian()
+				},
+				Expected: v.Transform(s.RotationMatrix()).Cartesian(),
+			})
+		}
+	}
+	RunCartesianTests(t, cases)
+}
+
+func TestMatrixQuaternion(t *testing.T) {
+	cases := []QuaternionTest{
+		{
+			Operation: func(Quaternion) Quaternion {
+				m := NewRotationMatrixX(rad(1, 3))
+				return m.Quaternion()
+			},
+			Expected: NewAxisAngleQuaternion(AxisX.Cartesian, rad(1, 3)),
+		},
+		{
+			Operation: func(Quaternion) Quaternion {
+				m := NewRotationMatrixY(rad(-3, 4))
+				return m.Quaternion()
+			},
+			Expected: NewAxisAngleQuaternion(AxisY.Cartesian, rad(-3, 4)),
+		},
+		{
+			Operation: func(Quaternion) Quaternion {
+				m := NewRotationMatrixZ(rad(1, 1))
+				return m.Quaternion()
+			},
+			Expected: NewAxisAngleQuaternion(AxisZ.Cartesian, rad(1, 1)),
+		},
+		{
+			Operation: func(Quaternion) Quaternion {
+				m := NewRotationMatrixX(rad(1, 1))
+				return m.Quaternion()
+			},
+			Expected: NewAxisAngleQuaternion(AxisX.Cartesian, rad(1, 1)),
+		},
+		{
+			Operation: func(Quaternion) Quaternion {
+				q := NewAxisAngleQuaternion(OctantNXYNZ.Cartesian, rad(5, 7))
+				return q.RotationMatrix().Quaternion()
+			},
+			Expected: NewAxisAngleQuaternion(OctantNXYNZ.Cartesian, rad(5, 7)),
+		},
+		{
+			Operation: func(Quaternion) Quaternion {
+				s := OctantXNYNZ.Spherical
+				return s.RotationMatrix().Quaternion()
+			},
+			Expected: OctantXNYNZ.Spherical.Quaternion(),
+		},
+	}
+	RunQuaternionTests(t, cases)
+}
+
+func TestQuaternionMultiply(t *testing.T) {
+	cases := []CartesianTest{
+		{
+			Initial: AxisX.Cartesian,
+			Operation: func(v Cartesian) Cartesian {
+				a := NewAxisAngleQuaternion(AxisZ.Cartesian, rad(1, 2))
+				b := NewAxisAngleQuaternion(AxisX.Cartesian, rad(1, 2))
+				return a.Multiply(b).Rotate(v).Cartesian()
+			},
+			Expected: AxisY.Cartesian,
+		},
+		{
+			Initial: AxisY.Cartesian,
+			Operation: func(v Cartesian) Cartesian {
+				a := NewAxisAngleQuaternion(AxisZ.Cartesian, rad(1, 2))
+				b := NewAxisAngleQuaternion(AxisX.Cartesian, rad(1, 2))
+				return a.Multiply(b).Rotate(v).Cartesian()
+			},
+			Expected: AxisZ.Cartesian,
+		},
+		{
+			Initial: OctantXYZ3.Cartesian,
+			Operation: func(v Cartesian) Cartesian {
+				a := NewAxisAngleQuaternion(AxisY.Cartesian, rad(1, 5))
+				b := NewAxisAngleQuaternion(AxisZ.Cartesian, rad(4, 3))
+				return a.Multiply(b).Rotate(v).Cartesian()
+			},
+			Expected: OctantXYZ3.Cartesian.
+				Transform(NewRotationMatrixY(rad(1, 5)).Multiply(NewRotationMatrixZ(rad(4, 3)))).
+				Cartesian(),
+		},
+	}
+	RunCartesianTests(t, cases)
+}
+
+func TestQuaternionInverse(t *testing.T) {
+	cases := []QuaternionTest{
+		{
+			Initial: NewAxisAngleQuaternion(AxisX.Cartesian, rad(1, 3)),
+			Operation: func(q Quaternion) Quaternion {
+				return q.Multiply(q.Inverse())
+			},
+			Expected: NewIdentityQuaternion(),
+		},
+		{
+			Initial: NewQuaternion(2, 3, 5, 7),
+			Operation: func(q Quaternion) Quaternion {
+				return q.Inverse().Multiply(q)
+			},
+			Expected: NewIdentityQuaternion(),
+		},
+		{
+			Initial: NewQuaternion(2, 3, 5, 7),
+			Operation: func(q Quaternion) Quaternion {
+				return q.Normalize().Inverse()
+			},
+			Expected: NewQuaternion(2, 3, 5, 7).Normalize().Conjugate(),
+		},
+	}
+	RunQuaternionTests(t, cases)
+}
+
+func TestQuaternionSlerp(t *testing.T) {
+	cases := []QuaternionTest{
+		{
+			Initial: NewIdentityQuaternion(),
+			Operation: func(q Quaternion) Quaternion {
+				r := NewAxisAngleQuaternion(AxisZ.Cartesian, rad(1, 2))
+				return q.Slerp(r, 0)
+			},
+			Expected: NewIdentityQuaternion(),
+		},
+		{
+			Initial: NewIdentityQuaternion(),
+			Operation: func(q Quaternion) Quaternion {
+				r := NewAxisAngleQuaternion(AxisZ.Cartesian, rad(1, 2))
+				return q.Slerp(r, 1)
+			},
+			Expected: NewAxisAngleQuaternion(AxisZ.Cartesian, rad(1, 2)),
+		},
+		{
+			Initial: NewIdentityQuaternion(),
+			Operation: func(q Quaternion) Quaternion {
+				r := NewAxisAngleQuaternion(AxisZ.Cartesian, rad(1, 2))
+				return q.Slerp(r, 0.5)
+			},
+			Expected: NewAxisAngleQuaternion(AxisZ.Cartesian, rad(1, 4)),
+		},
+		{
+			Initial: NewAxisAngleQuaternion(AxisX.Cartesian, rad(1, 6)),
+			Operation: func(q Quaternion) Quaternion {
+				r := NewAxisAngleQuaternion(AxisX.Cartesian, rad(1, 2))
+				return q.Slerp(r, 0.25)
+			},
+			Expected: NewAxisAngleQuaternion(AxisX.Cartesian, rad(1, 4)),
+		},
+		{
+			// The shortest path is taken when q and r are in opposite hemispheres
+			Initial: NewAxisAngleQuaternion(AxisY.Cartesian, rad(-3, 4)),
+			Operation: func(q Quaternion) Quaternion {
+				r := NewAxisAngleQuaternion(AxisY.Cartesian, rad(3, 4))
+				return q.Slerp(r, 0.5)
+			},
+			Expected: NewAxisAngleQuaternion(AxisY.Cartesian, rad(1, 1)),
+		},
+	}
+	RunQuaternionTests(t, cases)
+}
+
+func TestQuaternionAxisAngle(t *testing.T) {
+	cases := []struct {
+		Quaternion Quaternion
+		Axis       Cartesian
+		Angle      float64
+	}{
+		{
+			Quaternion: NewIdentityQuaternion(),
+			Axis:       AxisZ.Cartesian,
+			Angle:      0,
+		},
+		{
+			Quaternion: NewAxisAngleQuaternion(AxisX3.Cartesian, rad(1, 2)),
+			Axis:       AxisX.Cartesian,
+			Angle:      rad(1, 2),
+		},
+		{
+			Quaternion: NewAxisAngleQuaternion(AxisY.Cartesian, rad(-1, 2)),
+			Axis:       AxisYN.Cartesian,
+			Angle:      rad(1, 2),
+		},
+	}
+	for i, c := range cases {
+		axis, angle := c.Quaternion.AxisAngle()
+		if !CartesiansEqual(c.Axis, axis) || !near(c.Angle, angle) {
+			t.Fatalf("AxisAngle %v failed:\n\tExpected: %v %v,\n\tActual: %v %v", i, c.Axis, c.Angle, axis, angle)
+		}
+	}
+}
@@ -0,0 +1,199 @@
+package space
+
+import (
+	"fmt"
+	"math"
+)
+
+// Ray is a half-line which starts at an origin and extends in a direction
+type Ray struct {
+	// Origin is where the ray starts
+	Origin Cartesian
+	// Direction is where the ray points (R is ignored)
+	Direction Spherical
+}
+
+// Hit describes where a Ray meets a surface
+type Hit struct {
+	// Distance is how far along the ray the surface was met
+	Distance float64
+	// Point is where the surface was met
+	Point Cartesian
+	// Normal is the surface normal (of length one) at Point
+	Normal Cartesian
+}
+
+// NewRay creates a new Ray from an origin and direction
+func NewRay(origin Cartesian, direction Spherical) Ray {
+	return Ray{
+		Origin:    origin,
+		Direction: direction,
+	}
+}
+
+// Ray returns a Ray which starts at the object's location and extends towards its orientation
+func (o Object) Ray() Ray {
+	return NewRay(o.location, o.orientation)
+}
+
+// At returns the point which is distance along r
+func (r Ray) At(distance float64) Cartesian {
+	return r.Origin.Translate(r.direction().Scale(distance)).Cartesian()
+}
+
+// IntersectPlane finds where r meets the plane through point with the given normal
+// The normal of the Hit faces the origin of r.
+func (r Ray) IntersectPlane(point Cartesian, normal Vector) (Hit, bool) {
+	n := normal.Normalize().Cartesian()
+	d := r.direction()
+	denom := n.Dot(d)
+	if near(denom, 0) {
+		return Hit{}, false
+	}
+	distance := n.Dot(point.Subtract(r.Origin)) / denom
+	if distance < 0 {
+		return Hit{}, false
+	}
+	if denom > 0 {
+		n = n.Negate().Cartesian()
+	}
+	return Hit{
+		Distance: distance,
+		Point:    r.At(distance),
+		Normal:   n,
+	}, true
+}
+
+// IntersectSphere finds where r first meets the surface of the sphere
+// If r starts inside the sphere, the Hit is where r leaves it.
+// The normal of the Hit points out of the sphere.
+func (r Ray) IntersectSphere(center Cartesian, radius float64) (Hit, bool) {
+	d := r.direction()
+	oc := r.Origin.Subtract(center)
+	b := oc.Dot(d)
+	c := oc.LengthSquared() - (radius * radius)
+	discriminant := (b * b) - c
+	if discriminant < 0 {
+		return Hit{}, false
+	}
+	root := math.Sqrt(discriminant)
+	distance := -b - root
+	if distance < 0 {
+		distance = -b + root
+	}
+	if distance < 0 {
+		return Hit{}, false
+	}
+	p := r.At(distance)
+	return Hit{
+		Distance: distance,
+		Point:    p,
+		Normal:   p.Subtract(center).Normalize().Cartesian(),
+	}, true
+}
+
+// IntersectAABB finds where r first meets the surface of b
+// If r starts inside b, the Hit is where r leaves it.
+// The normal of the Hit points out of b.
+func (r Ray) IntersectAABB(b AABB) (Hit, bool) {
+	d := r.direction()
+	origin := [3]float64{r.Origin.X, r.Origin.Y, r.Origin.Z}
+	direction := [3]float64{d.X, d.Y, d.Z}
+	min := [3]float64{b.Min.X, b.Min.Y, b.Min.Z}
+	max := [3]float64{b.Max.X, b.Max.Y, b.Max.Z}
+
+	enter, exit := math.Inf(-1), math.Inf(1)
+	enterAxis, exitAxis := 0, 0
+	enterSign, exitSign := 0.0, 0.0
+	for axis := 0; axis < 3; axis++ {
+		if direction[axis] == 0 {
+			if origin[axis] < min[axis] || origin[axis] > max[axis] {
+				return Hit{}, false
+			}
+			continue
+		}
+		t1 := (min[axis] - origin[axis]) / direction[axis]
+		t2 := (max[axis] - origin[axis]) / direction[axis]
+		// sign is the direction of the outward normal of the face which is entered
+		sign := -1.0
+		if t1 > t2 {
+			t1, t2 = t2, t1
+			sign = 1.0
+		}
+		if t1 > enter {
+			enter, enterAxis, enterSign = t1, axis, sign
+		}
+		if t2 < exit {
+			exit, exitAxis, exitSign = t2, axis, -sign
+		}
+		if enter > exit || exit < 0 {
+			return Hit{}, false
+		}
+	}
+
+	distance, axis, sign := enter, enterAxis, enterSign
+	if distance < 0 {
+		distance, axis, sign = exit, exitAxis, exitSign
+	}
+	normal := [3]float64{}
+	normal[axis] = sign
+	return Hit{
+		Distance: distance,
+		Point:    r.At(distance),
+		Normal:   Cartesian{normal[0], normal[1], normal[2]},
+	}, true
+}
+
+// IntersectTriangle finds where r meets the triangle with corners a, b and c
+// The normal of the Hit faces the origin of r.
+func (r Ray) IntersectTriangle(a, b, c Cartesian) (Hit, bool) {
+	// Möller–Trumbore
+	d := r.direction()
+	ab := b.Subtract(a)
+	ac := c.Subtract(a)
+	p := d.Cross(ac)
+	det := ab.Dot(p)
+	// The ray is parallel to the triangle (or the triangle has no area)
+	if math.Abs(det) < MinErr*ab.Length()*ac.Length() {
+		return Hit{}, false
+	}
+	inv := 1 / det
+	ao := r.Origin.Subtract(a)
+	u := ao.Dot(p) * inv
+	if u < -MinErr || u > 1+MinErr {
+		return Hit{}, false
+	}
+	q := ao.Cross(ab)
+	v := d.Dot(q) * inv
+	if v < -MinErr || u+v > 1+MinErr {
+		return Hit{}, false
+	}
+	distance := ac.Dot(q) * inv
+	if distance < 0 {
+		return Hit{}, false
+	}
+	n := ab.Cross(ac).Normalize().Cartesian()
+	if n.Dot(d) > 0 {
+		n = n.Negate().Cartesian()
+	}
+	return Hit{
+		Distance: distance,
+		Point:    r.At(distance),
+		Normal:   n,
+	}, true
+}
+
+// direction returns the direction of r with a length of one
+func (r Ray) direction() Cartesian {
+	d := r.Direction
+	d.R = 1
+	return d.Cartesian()
+}
+
+func (r Ray) String() string {
+	return fmt.Sprintf("{Origin:%v, Direction:%v}", r.Origin, r.Direction)
+}
+
+func (h Hit) String() string {
+	return fmt.Sprintf("{Distance:%4.2f, Point:%v, Normal:%v}", h.Distance, h.Point, h.Normal)
+}
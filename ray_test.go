@@ -0,0 +1,268 @@
+package space
+
+import (
+	"testing"
+)
+
+type HitTest struct {
+	Ray       Ray
+	Operation func(Ray) (Hit, bool)
+	Expected  Hit
+	OK        bool
+}
+
+func RunHitTests(t *testing.T, cases []HitTest) {
+	for i, c := range cases {
+		actual, ok := c.Operation(c.Ray)
+		if ok != c.OK {
+			t.Fatalf("Test %v failed:\n\tExpected ok: %v,\n\tActual ok: %v", i, c.OK, ok)
+		}
+		if !ok {
+			continue
+		}
+		if !HitsEqual(c.Expected, actual) {
+			t.Fatalf("Test %v failed. Hits were not equal:\n\tExpected: %v,\n\tActual: %v", i, c.Expected, actual)
+		}
+	}
+}
+
+// HitsEqual compares Hits
+func HitsEqual(a, b Hit) bool {
+	if !near(a.Distance, b.Distance) {
+		return false
+	}
+	if !CartesiansEqual(a.Point, b.Point) {
+		return false
+	}
+	return CartesiansEqual(a.Normal, b.Normal)
+}
+
+func TestRayAt(t *testing.T) {
+	cases := []CartesianTest{
+		{
+			Operation: func(Cartesian) Cartesian {
+				return NewRay(Cartesian{1, 2, 3}, AxisZ.Spherical).At(2)
+			},
+			Expected: Cartesian{1, 2, 5},
+		},
+		{
+			Operation: func(Cartesian) Cartesian {
+				return NewRay(Cartesian{1, 2, 3}, AxisXN3.Spherical).At(2)
+			},
+			Expected: Cartesian{-1, 2, 3},
+		},
+		{
+			Operation: func(Cartesian) Cartesian {
+				o := NewObject(Cartesian{1, 1, 1}, OctantXYZ3.Spherical, AxisZ.Spherical)
+				return o.Ray().At(3)
+			},
+			Expected: Cartesian{1, 1, 1}.Translate(OctantXYZ3.Cartesian).Cartesian(),
+		},
+	}
+	RunCartesianTests(t, cases)
+}
+
+func TestRayIntersectPlane(t *testing.T) {
+	cases := []HitTest{
+		{
+			Ray: NewRay(Cartesian{0, 0, 5}, AxisZN.Spherical),
+			Operation: func(r Ray) (Hit, bool) {
+				return r.IntersectPlane(Cartesian{7, 7, 0}, AxisZ.Cartesian)
+			},
+			Expected: Hit{Distance: 5, Point: Cartesian{0, 0, 0}, Normal: Cartesian{0, 0, 1}},
+			OK:       true,
+		},
+		{
+			// The normal is flipped to face the ray
+			Ray: NewRay(Cartesian{0, 0, 5}, AxisZN.Spherical),
+			Operation: func(r Ray) (Hit, bool) {
+				return r.IntersectPlane(Cartesian{0, 0, 2}, AxisZN3.Spherical)
+			},
+			Expected: Hit{Distance: 3, Point: Cartesian{0, 0, 2}, Normal: Cartesian{0, 0, 1}},
+			OK:       true,
+		},
+		{
+			Ray: NewRay(Cartesian{0, 0, 0}, OctantXYZ.Spherical),
+			Operation: func(r Ray) (Hit, bool) {
+				return r.IntersectPlane(Cartesian{2, 0, 0}, AxisX.Cartesian)
+			},
+			Expected: Hit{Distance: 2 / OctantXYZ.Cartesian.X, Point: Cartesian{2, 2, 2}, Normal: Cartesian{-1, 0, 0}},
+			OK:       true,
+		},
+		{
+			// The plane is behind the ray
+			Ray: NewRay(Cartesian{0, 0, 5}, AxisZ.Spherical),
+			Operation: func(r Ray) (Hit, bool) {
+				return r.IntersectPlane(Cartesian{0, 0, 0}, AxisZ.Cartesian)
+			},
+			OK: false,
+		},
+		{
+			// The ray is parallel to the plane
+			Ray: NewRay(Cartesian{0, 0, 5}, AxisX.Spherical),
+			Operation: func(r Ray) (Hit, bool) {
+				return r.IntersectPlane(Cartesian{0, 0, 0}, AxisZ.Cartesian)
+			},
+			OK: false,
+		},
+	}
+	RunHitTests(t, cases)
+}
+
+func TestRayIntersectSphere(t *testing.T) {
+	cases := []HitTest{
+		{
+			Ray: NewRay(Cartesian{-5, 0, 0}, AxisX.Spherical),
+			Operation: func(r Ray) (Hit, bool) {
+				return r.IntersectSphere(Cartesian{0, 0, 0}, 1)
+			},
+			Expected: Hit{Distance: 4, Point: Cartesian{-1, 0, 0}, Normal: Cartesian{-1, 0, 0}},
+			OK:       true,
+		},
+		{
+			// The ray starts inside the sphere
+			Ray: NewRay(Cartesian{0, 0, 0}, AxisY.Spherical),
+			Operation: func(r Ray) (Hit, bool) {
+				return r.IntersectSphere(Cartesian{0, 0, 0}, 3)
+			},
+			Expected: Hit{Distance: 3, Point: Cartesian{0, 3, 0}, Normal: Cartesian{0, 1, 0}},
+			OK:       true,
+		},
+		{
+			Ray: NewRay(Cartesian{0, 1, 10}, AxisZN.Spherical),
+			Operation: func(r Ray) (Hit, bool) {
+				return r.IntersectSphere(Cartesian{0, 1, 1}, 2)
+			},
+			Expected: Hit{Distance: 7, Point: Cartesian{0, 1, 3}, Normal: Cartesian{0, 0, 1}},
+			OK:       true,
+		},
+		{
+			Ray: NewRay(Cartesian{-5, 2, 0}, AxisX.Spherical),
+			Operation: func(r Ray) (Hit, bool) {
+				return r.IntersectSphere(Cartesian{0, 0, 0}, 1)
+			},
+			OK: false,
+		},
+		{
+			// The sphere is behind the ray
+			Ray: NewRay(Cartesian{5, 0, 0}, AxisX.Spherical),
+			Operation: func(r Ray) (Hit, bool) {
+				return r.IntersectSphere(Cartesian{0, 0, 0}, 1)
+			},
+			OK: false,
+		},
+	}
+	RunHitTests(t, cases)
+}
+
+func TestRayIntersectAABB(t *testing.T) {
+	cases := []HitTest{
+		{
+			Ray: NewRay(Cartesian{-5, 0, 0}, AxisX.Spherical),
+			Operation: func(r Ray) (Hit, bool) {
+				return r.IntersectAABB(unitAABB)
+			},
+			Expected: Hit{Distance: 4, Point: Cartesian{-1, 0, 0}, Normal: Cartesian{-1, 0, 0}},
+			OK:       true,
+		},
+		{
+			Ray: NewRay(Cartesian{0.5, 0.5, 5}, AxisZN.Spherical),
+			Operation: func(r Ray) (Hit, bool) {
+				return r.IntersectAABB(unitAABB)
+			},
+			Expected: Hit{Distance: 4, Point: Cartesian{0.5, 0.5, 1}, Normal: Cartesian{0, 0, 1}},
+			OK:       true,
+		},
+		{
+			// The ray starts inside the box
+			Ray: NewRay(Cartesian{0, 0, 0}, AxisYN.Spherical),
+			Operation: func(r Ray) (Hit, bool) {
+				return r.IntersectAABB(unitAABB)
+			},
+			Expected: Hit{Distance: 1, Point: Cartesian{0, -1, 0}, Normal: Cartesian{0, -1, 0}},
+			OK:       true,
+		},
+		{
+			Ray: NewRay(Cartesian{-3, -2, -2}, OctantXYZ.Spherical),
+			Operation: func(r Ray) (Hit, bool) {
+				return r.IntersectAABB(unitAABB)
+			},
+			Expected: Hit{Distance: 2 / OctantXYZ.Cartesian.X, Point: Cartesian{-1, 0, 0}, Normal: Cartesian{-1, 0, 0}},
+			OK:       true,
+		},
+		{
+			Ray: NewRay(Cartesian{-5, 2, 0}, AxisX.Spherical),
+			Operation: func(r Ray) (Hit, bool) {
+				return r.IntersectAABB(unitAABB)
+			},
+			OK: false,
+		},
+		{
+			// The box is behind the ray
+			Ray: NewRay(Cartesian{5, 0, 0}, AxisX.Spherical),
+			Operation: func(r Ray) (Hit, bool) {
+				return r.IntersectAABB(unitAABB)
+			},
+			OK: false,
+		},
+	}
+	RunHitTests(t, cases)
+}
+
+func TestRayIntersectTriangle(t *testing.T) {
+	a := Cartesian{0, 0, 0}
+	b := Cartesian{2, 0, 0}
+	c := Cartesian{0, 2, 0}
+	cases := []HitTest{
+		{
+			Ray: NewRay(Cartesian{0.5, 0.5, 3}, AxisZN.Spherical),
+			Operation: func(r Ray) (Hit, bool) {
+				return r.IntersectTriangle(a, b, c)
+			},
+			Expected: Hit{Distance: 3, Point: Cartesian{0.5, 0.5, 0}, Normal: Cartesian{0, 0, 1}},
+			OK:       true,
+		},
+		{
+			// The triangle is hit from behind, so the normal is flipped to face the ray
+			Ray: NewRay(Cartesian{0.5, 0.5, -3}, AxisZ.Spherical),
+			Operation: func(r Ray) (Hit, bool) {
+				return r.IntersectTriangle(a, b, c)
+			},
+			Expected: Hit{Distance: 3, Point: Cartesian{0.5, 0.5, 0}, Normal: Cartesian{0, 0, -1}},
+			OK:       true,
+		},
+		{
+			// The ray passes through an edge
+			Ray: NewRay(Cartesian{1, 1, 3}, AxisZN.Spherical),
+			Operation: func(r Ray) (Hit, bool) {
+				return r.IntersectTriangle(a, b, c)
+			},
+			Expected: Hit{Distance: 3, Point: Cartesian{1, 1, 0}, Normal: Cartesian{0, 0, 1}},
+			OK:       true,
+		},
+		{
+			Ray: NewRay(Cartesian{1.5, 1.5, 3}, AxisZN.Spherical),
+			Operation: func(r Ray) (Hit, bool) {
+				return r.IntersectTriangle(a, b, c)
+			},
+			OK: false,
+		},
+		{
+			// The ray is parallel to the triangle
+			Ray: NewRay(Cartesian{-1, 0.5, 0}, AxisX.Spherical),
+			Operation: func(r Ray) (Hit, bool) {
+				return r.IntersectTriangle(a, b, c)
+			},
+			OK: false,
+		},
+		{
+			// The triangle is behind the ray
+			Ray: NewRay(Cartesian{0.5, 0.5, 3}, AxisZ.Spherical),
+			Operation: func(r Ray) (Hit, bool) {
+				return r.IntersectTriangle(a, b, c)
+			},
+			OK: false,
+		},
+	}
+	RunHitTests(t, cases)
+}
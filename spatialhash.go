@@ -0,0 +1,222 @@
+package space
+
+import (
+	"fmt"
+	"math"
+)
+
+// HashPoint is a location in a SpatialHash, with a key which identifies it
+type HashPoint struct {
+	Location Cartesian
+	// Key identifies the point, so that it can be moved or removed
+	// Keys are compared with ==, so they must be comparable and unique within a SpatialHash.
+	Key interface{}
+}
+
+// HashCell is the position of a cell in a SpatialHash, counted in cells from the origin
+// The cell at (0, 0, 0) spans from the origin to (size, size, size).
+type HashCell struct {
+	X, Y, Z int
+}
+
+func (c HashCell) String() string {
+	return fmt.Sprintf("(%d, %d, %d)", c.X, c.Y, c.Z)
+}
+
+// SpatialHash finds points near a location by sorting them into cubic cells of equal size
+// It is fastest when points are spread evenly and cells hold a few points each.
+// Unlike a tree, moving a point only touches the cells it leaves and enters,
+// so points can be moved every frame without rebuilding anything.
+// SpatialHashes are not safe for concurrent use.
+type SpatialHash struct {
+	size  float64
+	cells map[HashCell][]*hashEntry
+	// entries finds the entry of each key, so that it can be moved or removed
+	entries map[interface{}]*hashEntry
+}
+
+type hashEntry struct {
+	HashPoint
+	cell HashCell
+	// index is the position of the entry in its cell
+	index int
+}
+
+// NewSpatialHash creates an empty SpatialHash with cells of the given size, which must be positive
+// Queries are quickest when the size is close to the radius which is usually searched.
+func NewSpatialHash(size float64) *SpatialHash {
+	return &SpatialHash{
+		size:    size,
+		cells:   map[HashCell][]*hashEntry{},
+		entries: map[interface{}]*hashEntry{},
+	}
+}
+
+// CellSize returns the length of each side of the cells of h
+func (h *SpatialHash) CellSize() float64 {
+	return h.size
+}
+
+// Len returns the number of points in h
+func (h *SpatialHash) Len() int {
+	return len(h.entries)
+}
+
+// Cell returns the cell of h which v falls in
+func (h *SpatialHash) Cell(v Vector) HashCell {
+	return h.cellOf(v.Cartesian())
+}
+
+// cellOf returns the cell of h which c falls in
+func (h *SpatialHash) cellOf(c Cartesian) HashCell {
+	return HashCell{
+		X: int(math.Floor(c.X / h.size)),
+		Y: int(math.Floor(c.Y / h.size)),
+		Z: int(math.Floor(c.Z / h.size)),
+	}
+}
+
+// Insert adds a point at location to h, identified by key
+// If key is already in h, its point is moved to location instead.
+func (h *SpatialHash) Insert(key interface{}, location Cartesian) {
+	if h.Update(key, location) {
+		return
+	}
+	e := &hashEntry{
+		HashPoint: HashPoint{
+			Location: location,
+			Key:      key,
+		},
+	}
+	h.entries[key] = e
+	h.add(e, h.cellOf(location))
+}
+
+// Update moves the point identified by key to location
+// If the point stays in the same cell, no cells are changed.
+// If key is not in h, false is returned.
+func (h *SpatialHash) Update(key interface{}, location Cartesian) bool {
+	e, ok := h.entries[key]
+	if !ok {
+		return false
+	}
+	e.Location = location
+	if cell := h.cellOf(location); cell != e.cell {
+		h.remove(e)
+		h.add(e, cell)
+	}
+	return true
+}
+
+// Remove takes the point identified by key out of h
+// If key is not in h, false is returned.
+func (h *SpatialHash) Remove(key interface{}) bool {
+	e, ok := h.entries[key]
+	if !ok {
+		return false
+	}
+	delete(h.entries, key)
+	h.remove(e)
+	return true
+}
+
+// Location returns the location of the point identified by key
+// If key is not in h, ok is false.
+func (h *SpatialHash) Location(key interface{}) (location Cartesian, ok bool) {
+	e, ok := h.entries[key]
+	if !ok {
+		return Cartesian{}, false
+	}
+	return e.Location, true
+}
+
+// Points returns every point in h, in no particular order
+func (h *SpatialHash) Points() []HashPoint {
+	points := make([]HashPoint, 0, len(h.entries))
+	for _, e := range h.entries {
+		points = append(points, e.HashPoint)
+	}
+	return points
+}
+
+// add puts e at the end of cell
+func (h *SpatialHash) add(e *hashEntry, cell HashCell) {
+	e.cell = cell
+	e.index = len(h.cells[cell])
+	h.cells[cell] = append(h.cells[cell], e)
+}
+
+// remove takes e out of its cell, by moving the last entry of the cell into its place
+func (h *SpatialHash) remove(e *hashEntry) {
+	entries := h.cells[e.cell]
+	last := len(entries) - 1
+	if last == 0 {
+		delete(h.cells, e.cell)
+		return
+	}
+	entries[e.index] = entries[last]
+	entries[e.index].index = e.index
+	entries[last] = nil
+	h.cells[e.cell] = entries[:last]
+}
+
+// InCell returns the points in cell, in no particular order
+func (h *SpatialHash) InCell(cell HashCell) []HashPoint {
+	entries := h.cells[cell]
+	points := make([]HashPoint, len(entries))
+	for i, e := range entries {
+		points[i] = e.HashPoint
+	}
+	return points
+}
+
+// EachNeighbor calls fn for each point in the cell of v, and in the 26 cells around it
+// Every point within one cell size of v is visited, along with some which are further.
+// Nothing is allocated, so it suits checking every point against its neighbors each frame.
+// fn must not change h.
+func (h *SpatialHash) EachNeighbor(v Vector, fn func(p HashPoint)) {
+	center := h.cellOf(v.Cartesian())
+	for x := center.X - 1; x <= center.X+1; x++ {
+		for y := center.Y - 1; y <= center.Y+1; y++ {
+			for z := center.Z - 1; z <= center.Z+1; z++ {
+				for _, e := range h.cells[HashCell{x, y, z}] {
+					fn(e.HashPoint)
+				}
+			}
+		}
+	}
+}
+
+// Within returns the points in h which are no further than radius from v, in no particular order
+func (h *SpatialHash) Within(v Vector, radius float64) []HashPoint {
+	c := v.Cartesian()
+	radiusSquared := radius * radius
+	points := []HashPoint{}
+	check := func(entries []*hashEntry) {
+		for _, e := range entries {
+			if distanceSquared(e.Location, c) <= radiusSquared {
+				points = append(points, e.HashPoint)
+			}
+		}
+	}
+
+	offset := Cartesian{radius, radius, radius}
+	low := h.cellOf(c.Subtract(offset).Cartesian())
+	high := h.cellOf(c.Translate(offset).Cartesian())
+	spanned := float64(high.X-low.X+1) * float64(high.Y-low.Y+1) * float64(high.Z-low.Z+1)
+	// A large radius spans more cells than are filled, so checking the filled cells is quicker
+	if spanned > float64(len(h.cells)) {
+		for _, entries := range h.cells {
+			check(entries)
+		}
+		return points
+	}
+	for x := low.X; x <= high.X; x++ {
+		for y := low.Y; y <= high.Y; y++ {
+			for z := low.Z; z <= high.Z; z++ {
+				check(h.cells[HashCell{x, y, z}])
+			}
+		}
+	}
+	return points
+}
@@ -0,0 +1,223 @@
+package space
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// randomHashPoints returns n points spread through a 100 unit cube, with their index as key
+func randomHashPoints(n int, seed int64) []HashPoint {
+	points := make([]HashPoint, n)
+	for i, l := range randomCartesians(rand.New(rand.NewSource(seed)), n, 0, 100) {
+		points[i] = HashPoint{Location: l, Key: i}
+	}
+	return points
+}
+
+// hashKeys returns the keys of points made by randomHashPoints, which are their indices
+func hashKeys(points []HashPoint) []int {
+	keys := make([]int, len(points))
+	for i, p := range points {
+		keys[i] = p.Key.(int)
+	}
+	return keys
+}
+
+// hashWithin finds the keys of the points within radius of v by checking every point
+func hashWithin(points []HashPoint, v Cartesian, radius float64) []int {
+	locations := make([]Cartesian, len(points))
+	for i, p := range points {
+		locations[i] = p.Location
+	}
+	keys := []int{}
+	for _, i := range linearWithin(locations, v, radius) {
+		keys = append(keys, points[i].Key.(int))
+	}
+	return keys
+}
+
+// newTestSpatialHash creates a SpatialHash holding points
+func newTestSpatialHash(size float64, points []HashPoint) *SpatialHash {
+	h := NewSpatialHash(size)
+	for _, p := range points {
+		h.Insert(p.Key, p.Location)
+	}
+	return h
+}
+
+// checkSpatialHash compares the queries of h against checking every point
+func checkSpatialHash(t *testing.T, h *SpatialHash, points []HashPoint) {
+	if h.Len() != len(points) || !IndexSetsEqual(hashKeys(points), hashKeys(h.Points())) {
+		t.Fatalf("SpatialHash failed. Points were not equal")
+	}
+	for _, p := range points {
+		if location, ok := h.Location(p.Key); !ok || location != p.Location {
+			t.Fatalf("SpatialHash failed. Point %v was at %v", p, location)
+		}
+	}
+	for cell, entries := range h.cells {
+		for i, e := range entries {
+			if e.index != i || e.cell != cell || h.Cell(e.Location) != cell {
+				t.Fatalf("SpatialHash failed. Point %v was in the wrong place", e.HashPoint)
+			}
+		}
+	}
+
+	queries := []Cartesian{
+		{50, 50, 50},
+		{0, 0, 0},
+		{-20, 130, 40},
+		{12.5, 77.3, 3.9},
+	}
+	for i, q := range queries {
+		for _, radius := range []float64{0, 5, 20, 200} {
+			expected := hashWithin(points, q, radius)
+			if actual := hashKeys(h.Within(q, radius)); !IndexSetsEqual(expected, actual) {
+				t.Fatalf("Within %v failed at radius %v:\n\tExpected: %v,\n\tActual: %v", i, radius, expected, actual)
+			}
+		}
+
+		found := []HashPoint{}
+		h.EachNeighbor(q, func(p HashPoint) {
+			found = append(found, p)
+		})
+		center := h.Cell(q)
+		for _, p := range found {
+			c := h.Cell(p.Location)
+			if c.X < center.X-1 || c.X > center.X+1 || c.Y < center.Y-1 || c.Y > center.Y+1 || c.Z < center.Z-1 || c.Z > center.Z+1 {
+				t.Fatalf("EachNeighbor %v failed. Point %v in cell %v was not a neighbor of %v", i, p, c, center)
+			}
+		}
+		if !IndexSetsEqual(hashKeys(h.Within(q, h.CellSize())), hashWithin(found, q, h.CellSize())) {
+			t.Fatalf("EachNeighbor %v failed. Points within a cell were not visited", i)
+		}
+	}
+}
+
+func TestSpatialHashCell(t *testing.T) {
+	h := NewSpatialHash(2)
+	cases := []struct {
+		V        Cartesian
+		Expected HashCell
+	}{
+		{Cartesian{0, 0, 0}, HashCell{0, 0, 0}},
+		{Cartesian{1.9, 0.1, 1}, HashCell{0, 0, 0}},
+		{Cartesian{2, 4, 6}, HashCell{1, 2, 3}},
+		{Cartesian{-0.1, -2, -2.1}, HashCell{-1, -1, -2}},
+	}
+	for i, c := range cases {
+		if actual := h.Cell(c.V); actual != c.Expected {
+			t.Fatalf("Cell %v failed:\n\tExpected: %v,\n\tActual: %v", i, c.Expected, actual)
+		}
+	}
+}
+
+func TestSpatialHashInsert(t *testing.T) {
+	points := randomHashPoints(1000, 1)
+	for _, size := range []float64{1, 5, 30} {
+		checkSpatialHash(t, newTestSpatialHash(size, points), points)
+	}
+
+	h := NewSpatialHash(1)
+	h.Insert("a", Cartesian{0.5, 0.5, 0.5})
+	h.Insert("b", Cartesian{0.25, 0.5, 0.5})
+	h.Insert("a", Cartesian{3.5, 0.5, 0.5})
+	if h.Len() != 2 {
+		t.Fatalf("Insert failed. Reinserted key was added twice")
+	}
+	if location, ok := h.Location("a"); !ok || location != (Cartesian{3.5, 0.5, 0.5}) {
+		t.Fatalf("Insert failed. Reinserted key was not moved: %v", location)
+	}
+	expected := HashPoint{Location: Cartesian{0.25, 0.5, 0.5}, Key: "b"}
+	if actual := h.InCell(HashCell{0, 0, 0}); len(actual) != 1 || actual[0] != expected {
+		t.Fatalf("InCell failed:\n\tExpected: %v,\n\tActual: %v", expected, actual)
+	}
+	if _, ok := h.Location("c"); ok {
+		t.Fatalf("Location failed. Unknown key was found")
+	}
+}
+
+func TestSpatialHashUpdate(t *testing.T) {
+	points := randomHashPoints(1000, 2)
+	h := newTestSpatialHash(5, points)
+
+	// Nudge every point, so that some stay in their cell and some leave it
+	r := rand.New(rand.NewSource(3))
+	for i := range points {
+		p := &points[i]
+		p.Location = p.Location.Translate(Cartesian{r.Float64()*4 - 2, r.Float64()*4 - 2, r.Float64()*4 - 2}).Cartesian()
+		if !h.Update(p.Key, p.Location) {
+			t.Fatalf("Update %v failed. Point was not found", i)
+		}
+	}
+	checkSpatialHash(t, h, points)
+
+	if h.Update(-1, Cartesian{}) {
+		t.Fatalf("Update failed. Unknown key was updated")
+	}
+	if h.Len() != len(points) {
+		t.Fatalf("Update failed. Unknown key was added")
+	}
+}
+
+func TestSpatialHashRemove(t *testing.T) {
+	points := randomHashPoints(1000, 4)
+	h := newTestSpatialHash(5, points)
+	remaining := []HashPoint{}
+	for i, p := range points {
+		if i%3 != 0 {
+			remaining = append(remaining, p)
+			continue
+		}
+		if !h.Remove(p.Key) {
+			t.Fatalf("Remove %v failed. Point was not found", i)
+		}
+	}
+	if h.Remove(points[0].Key) {
+		t.Fatalf("Remove failed. Removed point was found again")
+	}
+	checkSpatialHash(t, h, remaining)
+
+	for _, p := range remaining {
+		h.Remove(p.Key)
+	}
+	if h.Len() != 0 || len(h.cells) != 0 {
+		t.Fatalf("Remove failed. Empty hash still had cells")
+	}
+}
+
+var benchmarkHashPoints []HashPoint
+
+func BenchmarkSpatialHashUpdate(b *testing.B) {
+	points := randomHashPoints(10000, 5)
+	h := newTestSpatialHash(5, points)
+	step := Cartesian{0.1, 0, 0}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p := &points[i%len(points)]
+		p.Location = p.Location.Translate(step).Cartesian()
+		h.Update(p.Key, p.Location)
+	}
+}
+
+func BenchmarkSpatialHashWithin(b *testing.B) {
+	h := newTestSpatialHash(10, randomHashPoints(10000, 6))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchmarkHashPoints = h.Within(kdQueries[i%len(kdQueries)], 10)
+	}
+}
+
+func BenchmarkSpatialHashEachNeighbor(b *testing.B) {
+	h := newTestSpatialHash(10, randomHashPoints(10000, 7))
+	count := 0
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		h.EachNeighbor(kdQueries[i%len(kdQueries)], func(HashPoint) {
+			count++
+		})
+	}
+}
@@ -68,6 +68,67 @@ func (s Spherical) Project(v Vector) Vector {
 	return c.Project(v)
 }
 
+// Negate returns s pointing in the opposite direction
+func (s Spherical) Negate() Vector {
+	s.P = math.Pi - s.P
+	return s.Rotate(math.Pi)
+}
+
+// Subtract shifts a Spherical by the negation of a Vector (subtraction in cartesian space)
+func (s Spherical) Subtract(v Vector) Vector {
+	c := s.Cartesian()
+	return c.Subtract(v)
+}
+
+// Dot returns the dot product of s and v
+func (s Spherical) Dot(v Vector) float64 {
+	c := s.Cartesian()
+	return c.Dot(v)
+}
+
+// Cross returns the cross product of s and v (s x v)
+func (s Spherical) Cross(v Vector) Vector {
+	c := s.Cartesian()
+	return c.Cross(v)
+}
+
+// Length returns the distance from the origin to s
+func (s Spherical) Length() float64 {
+	return math.Abs(s.R)
+}
+
+// LengthSquared returns the square of the distance from the origin to s
+func (s Spherical) LengthSquared() float64 {
+	return s.R * s.R
+}
+
+// Normalize returns s with a length of one
+// If s has no length, s is returned
+func (s Spherical) Normalize() Vector {
+	if s.R == 0 {
+		return s
+	}
+	if s.R < 0 {
+		s.R = 1
+		return s.Negate()
+	}
+	s.R = 1
+	return s
+}
+
+// DistanceTo returns the distance between s and v
+func (s Spherical) DistanceTo(v Vector) float64 {
+	c := s.Cartesian()
+	return c.DistanceTo(v)
+}
+
+// AngleTo returns the angle between s and v, in the range [0, pi]
+// If either s or v has no length, the angle is zero
+func (s Spherical) AngleTo(v Vector) float64 {
+	c := s.Cartesian()
+	return c.AngleTo(v)
+}
+
 // Rotate will adjust the rotation about Z by theta
 func (s Spherical) Rotate(theta float64) Spherical {
 	wrappedT := s.T + theta
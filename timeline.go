@@ -0,0 +1,278 @@
+package space
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// Easing maps progress through the time of a motion (0 to 1) onto progress through the motion itself
+// Easings start at 0 and end at 1, but may move at any speed in between.
+type Easing func(t float64) float64
+
+// EaseLinear moves at a constant speed
+func EaseLinear(t float64) float64 {
+	return t
+}
+
+// EaseStep holds still until the end, then jumps
+func EaseStep(t float64) float64 {
+	if t < 1 {
+		return 0
+	}
+	return 1
+}
+
+// EaseInQuad starts slowly and speeds up
+func EaseInQuad(t float64) float64 {
+	return t * t
+}
+
+// EaseOutQuad starts quickly and slows down
+func EaseOutQuad(t float64) float64 {
+	return t * (2 - t)
+}
+
+// EaseInOutQuad starts slowly, speeds up, and slows down again
+func EaseInOutQuad(t float64) float64 {
+	if t < 0.5 {
+		return 2 * t * t
+	}
+	return -1 + (4-2*t)*t
+}
+
+// EaseInOutCubic starts slowly, speeds up, and slows down again, more sharply than EaseInOutQuad
+func EaseInOutCubic(t float64) float64 {
+	if t < 0.5 {
+		return 4 * t * t * t
+	}
+	u := (2 * t) - 2
+	return 1 + (u * u * u / 2)
+}
+
+// EaseInOutSine starts slowly, speeds up, and slows down again, following a sine wave
+func EaseInOutSine(t float64) float64 {
+	return (1 - math.Cos(t*math.Pi)) / 2
+}
+
+// Pose is a location, orientation and rotation, as held by an Object
+type Pose struct {
+	Location    Cartesian
+	Orientation Spherical
+	Rotation    Spherical
+}
+
+// Pose returns the location, orientation and rotation of the object
+func (o Object) Pose() Pose {
+	return Pose{
+		Location:    o.location,
+		Orientation: o.orientation,
+		Rotation:    o.rotation,
+	}
+}
+
+// Apply moves o to p
+func (p Pose) Apply(o *Object) {
+	o.Move(p.Location, p.Orientation, p.Rotation)
+}
+
+// Interpolate moves between p (t = 0) and q (t = 1)
+// The location moves in a straight line, and the orientation and rotation turn together
+// along the shortest path, so the rotation stays orthogonal to the orientation.
+func (p Pose) Interpolate(q Pose, t float64) Pose {
+	from := NewObject(p.Location, p.Orientation, p.Rotation).Quaternion()
+	to := NewObject(q.Location, q.Orientation, q.Rotation).Quaternion()
+	turn := from.Slerp(to, t)
+
+	orientationLength := p.Orientation.Length() + (q.Orientation.Length()-p.Orientation.Length())*t
+	rotationLength := p.Rotation.Length() + (q.Rotation.Length()-p.Rotation.Length())*t
+	return Pose{
+		Location:    Lerp(p.Location, q.Location, t),
+		Orientation: turn.Rotate(Cartesian{0, 0, orientationLength}).Spherical(),
+		Rotation:    turn.Rotate(Cartesian{0, rotationLength, 0}).Spherical(),
+	}
+}
+
+func (p Pose) String() string {
+	return fmt.Sprintf("{Location:%v, Orientation:%v, Rotation:%v}", p.Location, p.Orientation, p.Rotation)
+}
+
+// Keyframe is a Pose which a Timeline passes through at a given time
+type Keyframe struct {
+	Pose
+	// Time is when the Pose is reached, from the start of the Timeline
+	Time time.Duration
+	// Easing shapes the motion from this keyframe to the next
+	// If Easing is nil, the motion is linear.
+	Easing Easing
+}
+
+// NewKeyframe creates a new Keyframe
+func NewKeyframe(time time.Duration, location Cartesian, orientation, rotation Spherical, easing Easing) Keyframe {
+	return Keyframe{
+		Pose: Pose{
+			Location:    location,
+			Orientation: orientation,
+			Rotation:    rotation,
+		},
+		Time:   time,
+		Easing: easing,
+	}
+}
+
+func (k Keyframe) String() string {
+	return fmt.Sprintf("{Time:%v, Pose:%v}", k.Time, k.Pose)
+}
+
+// PlaybackMode describes what a Timeline does outside the times of its keyframes
+type PlaybackMode int
+
+const (
+	// Once holds the first pose before the timeline and the last pose after it
+	Once PlaybackMode = iota
+	// Loop starts again from the first keyframe after reaching the last
+	Loop
+	// PingPong plays backwards after reaching the last keyframe, then forwards again after reaching the first
+	PingPong
+)
+
+func (m PlaybackMode) String() string {
+	switch m {
+	case Once:
+		return "Once"
+	case Loop:
+		return "Loop"
+	case PingPong:
+		return "PingPong"
+	}
+	return fmt.Sprintf("PlaybackMode(%d)", int(m))
+}
+
+// Timeline is a sequence of keyframes which describe motion over time
+type Timeline struct {
+	// Mode is what the timeline does outside the times of its keyframes
+	Mode PlaybackMode
+	// keyframes are in order of time
+	keyframes []Keyframe
+}
+
+// NewTimeline creates a new Timeline from keyframes, in any order
+func NewTimeline(mode PlaybackMode, keyframes ...Keyframe) *Timeline {
+	t := &Timeline{
+		Mode: mode,
+	}
+	for _, k := range keyframes {
+		t.Add(k)
+	}
+	return t
+}
+
+// Add inserts k into t
+// If t already has keyframes at the time of k, k is placed after them.
+func (t *Timeline) Add(k Keyframe) {
+	i := sort.Search(len(t.keyframes), func(i int) bool {
+		return t.keyframes[i].Time > k.Time
+	})
+	t.keyframes = append(t.keyframes, Keyframe{})
+	copy(t.keyframes[i+1:], t.keyframes[i:])
+	t.keyframes[i] = k
+}
+
+// Keyframes returns the keyframes of t, in order of time
+func (t *Timeline) Keyframes() []Keyframe {
+	keyframes := make([]Keyframe, len(t.keyframes))
+	copy(keyframes, t.keyframes)
+	return keyframes
+}
+
+// Start returns the time of the first keyframe
+func (t *Timeline) Start() time.Duration {
+	if len(t.keyframes) == 0 {
+		return 0
+	}
+	return t.keyframes[0].Time
+}
+
+// Duration returns the time from the first keyframe to the last
+func (t *Timeline) Duration() time.Duration {
+	if len(t.keyframes) == 0 {
+		return 0
+	}
+	return t.keyframes[len(t.keyframes)-1].Time - t.keyframes[0].Time
+}
+
+// Sample returns the pose of t at the given time
+// If t has no keyframes, there is no pose and ok is false.
+func (t *Timeline) Sample(at time.Duration) (pose Pose, ok bool) {
+	if len(t.keyframes) == 0 {
+		return Pose{}, false
+	}
+	at = t.wrap(at)
+
+	// next is the first keyframe after at
+	next := sort.Search(len(t.keyframes), func(i int) bool {
+		return t.keyframes[i].Time > at
+	})
+	if next == 0 {
+		return t.keyframes[0].Pose, true
+	}
+	if next == len(t.keyframes) {
+		return t.keyframes[next-1].Pose, true
+	}
+
+	from, to := t.keyframes[next-1], t.keyframes[next]
+	progress := float64(at-from.Time) / float64(to.Time-from.Time)
+	if from.Easing != nil {
+		progress = from.Easing(progress)
+	}
+	return from.Pose.Interpolate(to.Pose, progress), true
+}
+
+// Apply moves o to the pose of t at the given time
+// If t has no keyframes, o is not moved and ok is false.
+func (t *Timeline) Apply(o *Object, at time.Duration) (ok bool) {
+	pose, ok := t.Sample(at)
+	if ok {
+		pose.Apply(o)
+	}
+	return ok
+}
+
+// wrap returns the time within the keyframes of t which at plays, according to t.Mode
+func (t *Timeline) wrap(at time.Duration) time.Duration {
+	start := t.Start()
+	duration := t.Duration()
+	if t.Mode == Once || duration == 0 {
+		return at
+	}
+
+	period := duration
+	if t.Mode == PingPong {
+		period = 2 * duration
+	}
+	offset := (at - start) % period
+	if offset < 0 {
+		offset += period
+	}
+	if offset > duration {
+		offset = period - offset
+	}
+	return start + offset
+}
+
+// BlendTimelines returns a mix of the poses of a (weight = 0) and b (weight = 1) at the given time
+// If either timeline has no keyframes, the pose of the other is returned.
+// If neither has keyframes, ok is false.
+func BlendTimelines(a, b *Timeline, at time.Duration, weight float64) (pose Pose, ok bool) {
+	poseA, okA := a.Sample(at)
+	poseB, okB := b.Sample(at)
+	switch {
+	case okA && okB:
+		return poseA.Interpolate(poseB, weight), true
+	case okA:
+		return poseA, true
+	default:
+		return poseB, okB
+	}
+}
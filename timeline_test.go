@@ -0,0 +1,235 @@
+package space
+
+import (
+	"testing"
+	"time"
+)
+
+// PosesEqual compares Poses
+func PosesEqual(a, b Pose) bool {
+	return CartesiansEqual(a.Location, b.Location) &&
+		SphericalsEqual(a.Orientation, b.Orientation) &&
+		SphericalsEqual(a.Rotation, b.Rotation)
+}
+
+// newTestTimeline moves from the origin facing Z, to (10, 0, 0) facing X, to (10, 10, 0) facing Y
+// The rotation points up Z, except at the start where it points along -Y.
+func newTestTimeline(mode PlaybackMode) *Timeline {
+	return NewTimeline(mode,
+		NewKeyframe(2*time.Second, Cartesian{10, 0, 0}, AxisX.Spherical, AxisZ.Spherical, nil),
+		NewKeyframe(0, Cartesian{0, 0, 0}, AxisZ.Spherical, AxisYN.Spherical, EaseInOutQuad),
+		NewKeyframe(4*time.Second, Cartesian{10, 10, 0}, AxisY.Spherical, AxisZ.Spherical, nil),
+	)
+}
+
+func TestEasing(t *testing.T) {
+	easings := []Easing{
+		EaseLinear,
+		EaseStep,
+		EaseInQuad,
+		EaseOutQuad,
+		EaseInOutQuad,
+		EaseInOutCubic,
+		EaseInOutSine,
+	}
+	for i, e := range easings {
+		if !near(e(0), 0) || !near(e(1), 1) {
+			t.Fatalf("Easing %v failed. Did not start at 0 and end at 1: %v, %v", i, e(0), e(1))
+		}
+	}
+
+	cases := []struct {
+		Easing   Easing
+		T        float64
+		Expected float64
+	}{
+		{EaseLinear, 0.3, 0.3},
+		{EaseStep, 0.99, 0},
+		{EaseInQuad, 0.5, 0.25},
+		{EaseOutQuad, 0.5, 0.75},
+		{EaseInOutQuad, 0.25, 0.125},
+		{EaseInOutQuad, 0.5, 0.5},
+		{EaseInOutQuad, 0.75, 0.875},
+		{EaseInOutCubic, 0.25, 0.0625},
+		{EaseInOutCubic, 0.75, 0.9375},
+		{EaseInOutSine, 0.5, 0.5},
+	}
+	for i, c := range cases {
+		if actual := c.Easing(c.T); !near(c.Expected, actual) {
+			t.Fatalf("Easing %v failed:\n\tExpected: %v,\n\tActual: %v", i, c.Expected, actual)
+		}
+	}
+}
+
+func TestPoseInterpolate(t *testing.T) {
+	a := Pose{Location: Cartesian{0, 0, 0}, Orientation: AxisX.Spherical, Rotation: AxisZ.Spherical}
+	b := Pose{Location: Cartesian{4, 2, 0}, Orientation: AxisY.Spherical, Rotation: AxisZ.Spherical}
+	cases := []struct {
+		T        float64
+		Expected Pose
+	}{
+		{0, a},
+		{1, b},
+		{0.5, Pose{Location: Cartesian{2, 1, 0}, Orientation: NewSpherical(1, rad(1, 4), rad(1, 2)), Rotation: AxisZ.Spherical}},
+	}
+	for i, c := range cases {
+		if actual := a.Interpolate(b, c.T); !PosesEqual(c.Expected, actual) {
+			t.Fatalf("Interpolate %v failed. Poses were not equal:\n\tExpected: %v,\n\tActual: %v", i, c.Expected, actual)
+		}
+	}
+
+	// The rotation turns with the orientation, so it is always orthogonal
+	c := Pose{Location: Cartesian{0, 0, 0}, Orientation: AxisZ.Spherical, Rotation: AxisYN.Spherical}
+	for i := 0; i <= 10; i++ {
+		p := a.Interpolate(c, float64(i)/10)
+		if !near(p.Orientation.Dot(p.Rotation), 0) || !near(p.Orientation.Length(), 1) || !near(p.Rotation.Length(), 1) {
+			t.Fatalf("Interpolate %v failed. Rotation was not orthogonal to orientation: %v", i, p)
+		}
+	}
+}
+
+func TestTimelineAdd(t *testing.T) {
+	tl := newTestTimeline(Once)
+	tl.Add(NewKeyframe(time.Second, Cartesian{1, 0, 0}, AxisX.Spherical, AxisZ.Spherical, nil))
+	tl.Add(NewKeyframe(time.Second, Cartesian{2, 0, 0}, AxisX.Spherical, AxisZ.Spherical, nil))
+	expected := []time.Duration{0, time.Second, time.Second, 2 * time.Second, 4 * time.Second}
+	keyframes := tl.Keyframes()
+	if len(keyframes) != len(expected) {
+		t.Fatalf("Add failed. Expected %v keyframes, got %v", len(expected), len(keyframes))
+	}
+	for i, k := range keyframes {
+		if k.Time != expected[i] {
+			t.Fatalf("Add %v failed. Keyframes were out of order: %v", i, keyframes)
+		}
+	}
+	if keyframes[2].Location.X != 2 {
+		t.Fatalf("Add failed. Keyframe at an existing time was not placed after it: %v", keyframes)
+	}
+	if tl.Start() != 0 || tl.Duration() != 4*time.Second {
+		t.Fatalf("Add failed. Timeline spans %v from %v", tl.Duration(), tl.Start())
+	}
+}
+
+func TestTimelineSample(t *testing.T) {
+	halfway := Pose{
+		Location:    Cartesian{10, 5, 0},
+		Orientation: NewSpherical(1, rad(1, 4), rad(1, 2)),
+		Rotation:    AxisZ.Spherical,
+	}
+	start := Pose{Location: Cartesian{0, 0, 0}, Orientation: AxisZ.Spherical, Rotation: AxisYN.Spherical}
+	middle := Pose{Location: Cartesian{10, 0, 0}, Orientation: AxisX.Spherical, Rotation: AxisZ.Spherical}
+	end := Pose{Location: Cartesian{10, 10, 0}, Orientation: AxisY.Spherical, Rotation: AxisZ.Spherical}
+	cases := []struct {
+		Mode     PlaybackMode
+		At       time.Duration
+		Expected Pose
+	}{
+		{Once, 0, start},
+		{Once, 2 * time.Second, middle},
+		{Once, 3 * time.Second, halfway},
+		{Once, 4 * time.Second, end},
+		{Once, -time.Second, start},
+		{Once, 9 * time.Second, end},
+		{Loop, 7 * time.Second, halfway},
+		{Loop, 8 * time.Second, start},
+		{Loop, -time.Second, halfway},
+		{PingPong, 5 * time.Second, halfway},
+		{PingPong, 6 * time.Second, middle},
+		{PingPong, 8 * time.Second, start},
+		{PingPong, 11 * time.Second, halfway},
+		{PingPong, -2 * time.Second, middle},
+	}
+	for i, c := range cases {
+		actual, ok := newTestTimeline(c.Mode).Sample(c.At)
+		if !ok {
+			t.Fatalf("Sample %v failed. No pose was found", i)
+		}
+		if !PosesEqual(c.Expected, actual) {
+			t.Fatalf("Sample %v failed. Poses were not equal:\n\tExpected: %v,\n\tActual: %v", i, c.Expected, actual)
+		}
+	}
+
+	// The first segment is eased, so it is a quarter of the way at a quarter of the time
+	tl := newTestTimeline(Once)
+	pose, _ := tl.Sample(time.Second / 2)
+	if !near(pose.Location.X, 1.25) {
+		t.Fatalf("Sample failed. Easing was not applied: %v", pose)
+	}
+
+	if _, ok := NewTimeline(Loop).Sample(time.Second); ok {
+		t.Fatalf("Sample failed. Empty timeline had a pose")
+	}
+}
+
+func TestTimelineApply(t *testing.T) {
+	o := NewObject(Cartesian{5, 5, 5}, AxisX.Spherical, AxisY.Spherical)
+	if !newTestTimeline(Once).Apply(o, 4*time.Second) {
+		t.Fatalf("Apply failed. No pose was found")
+	}
+	expected := NewObject(Cartesian{10, 10, 0}, AxisY.Spherical, AxisZ.Spherical)
+	if !ObjectsEqual(expected, o) {
+		t.Fatalf("Apply failed. Objects were not equal:\n\tExpected: %v,\n\tActual: %v", expected, o)
+	}
+	if actual := o.Pose(); !PosesEqual(Pose{Location: Cartesian{10, 10, 0}, Orientation: AxisY.Spherical, Rotation: AxisZ.Spherical}, actual) {
+		t.Fatalf("Apply failed. Pose was %v", actual)
+	}
+
+	if NewTimeline(Once).Apply(o, 0) {
+		t.Fatalf("Apply failed. Empty timeline had a pose")
+	}
+	if !ObjectsEqual(expected, o) {
+		t.Fatalf("Apply failed. Empty timeline moved the object:\n\tExpected: %v,\n\tActual: %v", expected, o)
+	}
+}
+
+func TestBlendTimelines(t *testing.T) {
+	still := NewTimeline(Once, NewKeyframe(0, Cartesian{0, 10, 0}, AxisX.Spherical, AxisZ.Spherical, nil))
+	moving := newTestTimeline(Loop)
+	empty := NewTimeline(Once)
+	cases := []struct {
+		A, B     *Timeline
+		At       time.Duration
+		Weight   float64
+		Expected Pose
+		OK       bool
+	}{
+		{
+			A: still, B: moving, At: 2 * time.Second, Weight: 0.5,
+			Expected: Pose{Location: Cartesian{5, 5, 0}, Orientation: AxisX.Spherical, Rotation: AxisZ.Spherical},
+			OK:       true,
+		},
+		{
+			A: still, B: moving, At: 7 * time.Second, Weight: 0.5,
+			Expected: Pose{Location: Cartesian{5, 7.5, 0}, Orientation: NewSpherical(1, rad(1, 8), rad(1, 2)), Rotation: AxisZ.Spherical},
+			OK:       true,
+		},
+		{
+			A: still, B: moving, At: 2 * time.Second, Weight: 0,
+			Expected: Pose{Location: Cartesian{0, 10, 0}, Orientation: AxisX.Spherical, Rotation: AxisZ.Spherical},
+			OK:       true,
+		},
+		{
+			A: empty, B: still, At: 0, Weight: 0,
+			Expected: Pose{Location: Cartesian{0, 10, 0}, Orientation: AxisX.Spherical, Rotation: AxisZ.Spherical},
+			OK:       true,
+		},
+		{
+			A: still, B: empty, At: 0, Weight: 1,
+			Expected: Pose{Location: Cartesian{0, 10, 0}, Orientation: AxisX.Spherical, Rotation: AxisZ.Spherical},
+			OK:       true,
+		},
+		{
+			A: empty, B: empty, At: 0, Weight: 0.5,
+			OK: false,
+		},
+	}
+	for i, c := range cases {
+		actual, ok := BlendTimelines(c.A, c.B, c.At, c.Weight)
+		if ok != c.OK {
+			t.Fatalf("BlendTimelines %v failed:\n\tExpected ok: %v,\n\tActual ok: %v", i, c.OK, ok)
+		}
+		if ok && !PosesEqual(c.Expected, actual) {
+			t.Fatalf("BlendTimelines %v failed. Poses were not equal:\n\tExpected: %v,\n\tActual: %v", i, c.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package space
+
+// TransformBuilder combines transformations into a single Matrix
+// Transformations are applied in the order they are added, so
+//
+//	NewTransformBuilder().Scale(2, 2, 2).RotateZ(theta).Translate(v).Matrix()
+//
+// scales first, then rotates, then translates.
+// TransformBuilder is a value type, so a partial builder can be copied and extended separately.
+// The zero TransformBuilder is not usable; create one with NewTransformBuilder.
+type TransformBuilder struct {
+	m Matrix
+}
+
+// NewTransformBuilder creates a TransformBuilder which does not transform
+func NewTransformBuilder() TransformBuilder {
+	return TransformBuilder{
+		m: NewIdentityMatrix(),
+	}
+}
+
+// Transform adds the transformation m
+func (b TransformBuilder) Transform(m Matrix) TransformBuilder {
+	b.m = m.Multiply(b.m)
+	return b
+}
+
+// Translate adds a translation by v
+func (b TransformBuilder) Translate(v Vector) TransformBuilder {
+	return b.Transform(v.Cartesian().TranslationMatrix())
+}
+
+// RotateX adds a rotation about X
+func (b TransformBuilder) RotateX(theta float64) TransformBuilder {
+	return b.Transform(NewRotationMatrixX(theta))
+}
+
+// RotateY adds a rotation about Y
+func (b TransformBuilder) RotateY(theta float64) TransformBuilder {
+	return b.Transform(NewRotationMatrixY(theta))
+}
+
+// RotateZ adds a rotation about Z
+func (b TransformBuilder) RotateZ(theta float64) TransformBuilder {
+	return b.Transform(NewRotationMatrixZ(theta))
+}
+
+// RotateAxisAngle adds a rotation by angle about axis
+func (b TransformBuilder) RotateAxisAngle(axis Vector, angle float64) TransformBuilder {
+	return b.Transform(NewAxisAngleMatrix(axis, angle))
+}
+
+// Rotate adds the rotation described by q
+func (b TransformBuilder) Rotate(q Quaternion) TransformBuilder {
+	return b.Transform(q.RotationMatrix())
+}
+
+// Scale adds a scale along X, Y and Z
+func (b TransformBuilder) Scale(sx, sy, sz float64) TransformBuilder {
+	return b.Transform(NewScaleMatrix(sx, sy, sz))
+}
+
+// ScaleUniform adds the same scale along every axis
+func (b TransformBuilder) ScaleUniform(s float64) TransformBuilder {
+	return b.Transform(NewScaleMatrix(s, s, s))
+}
+
+// Shear adds a shear (see NewShearMatrix)
+func (b TransformBuilder) Shear(xy, xz, yx, yz, zx, zy float64) TransformBuilder {
+	return b.Transform(NewShearMatrix(xy, xz, yx, yz, zx, zy))
+}
+
+// Reflect adds a reflection across p
+func (b TransformBuilder) Reflect(p Plane) TransformBuilder {
+	return b.Transform(p.ReflectionMatrix())
+}
+
+// Matrix returns the combined transformation
+func (b TransformBuilder) Matrix() Matrix {
+	return b.m
+}
@@ -0,0 +1,98 @@
+package space
+
+import (
+	"testing"
+)
+
+func TestTransformBuilder(t *testing.T) {
+	cases := []CartesianTest{
+		{
+			Initial: Cartesian{2, 3, 5},
+			Operation: func(v Cartesian) Cartesian {
+				return v.Transform(NewTransformBuilder().Matrix()).Cartesian()
+			},
+			Expected: Cartesian{2, 3, 5},
+		},
+		{
+			// Scale, then rotate, then translate
+			Initial: Cartesian{1, 0, 0},
+			Operation: func(v Cartesian) Cartesian {
+				m := NewTransformBuilder().
+					Scale(2, 1, 1).
+					RotateZ(rad(1, 2)).
+					Translate(Cartesian{10, 0, 0}).
+					Matrix()
+				return v.Transform(m).Cartesian()
+			},
+			Expected: Cartesian{10, 2, 0},
+		},
+		{
+			// Translate, then rotate, then scale
+			Initial: Cartesian{1, 0, 0},
+			Operation: func(v Cartesian) Cartesian {
+				m := NewTransformBuilder().
+					Translate(Cartesian{10, 0, 0}).
+					RotateZ(rad(1, 2)).
+					Scale(2, 1, 1).
+					Matrix()
+				return v.Transform(m).Cartesian()
+			},
+			Expected: Cartesian{0, 11, 0},
+		},
+		{
+			Initial: Cartesian{0, 1, 0},
+			Operation: func(v Cartesian) Cartesian {
+				m := NewTransformBuilder().
+					RotateX(rad(1, 2)).
+					RotateY(rad(1, 2)).
+					ScaleUniform(3).
+					Matrix()
+				return v.Transform(m).Cartesian()
+			},
+			Expected: Cartesian{3, 0, 0},
+		},
+		{
+			Initial: Cartesian{2, 3, 5},
+			Operation: func(v Cartesian) Cartesian {
+				m := NewTransformBuilder().
+					Rotate(NewAxisAngleQuaternion(AxisZ.Cartesian, rad(1, 1))).
+					RotateAxisAngle(AxisZ.Spherical, rad(1, 1)).
+					Matrix()
+				return v.Transform(m).Cartesian()
+			},
+			Expected: Cartesian{2, 3, 5},
+		},
+		{
+			Initial: Cartesian{2, 3, 5},
+			Operation: func(v Cartesian) Cartesian {
+				m := NewTransformBuilder().
+					Shear(1, 0, 0, 0, 0, 0).
+					Reflect(NewPlane(AxisX.Cartesian, 1)).
+					Transform(NewRotationMatrixZ(rad(1, 1))).
+					Matrix()
+				return v.Transform(m).Cartesian()
+			},
+			Expected: Cartesian{3, -3, 5},
+		},
+	}
+	RunCartesianTests(t, cases)
+}
+
+func TestTransformBuilderCopy(t *testing.T) {
+	base := NewTransformBuilder().Translate(Cartesian{1, 2, 3})
+	scaled := base.ScaleUniform(2)
+	rotated := base.RotateZ(rad(1, 1))
+
+	expected := Cartesian{1, 2, 3}.TranslationMatrix()
+	if actual := base.Matrix(); !MatriciesEqual(expected, actual) {
+		t.Fatalf("TransformBuilder failed. Base was changed:\n\tExpected: %v,\n\tActual: %v", expected, actual)
+	}
+	expected = NewScaleMatrix(2, 2, 2).Multiply(Cartesian{1, 2, 3}.TranslationMatrix())
+	if actual := scaled.Matrix(); !MatriciesEqual(expected, actual) {
+		t.Fatalf("TransformBuilder failed. Matricies were not equal:\n\tExpected: %v,\n\tActual: %v", expected, actual)
+	}
+	expected = NewRotationMatrixZ(rad(1, 1)).Multiply(Cartesian{1, 2, 3}.TranslationMatrix())
+	if actual := rotated.Matrix(); !MatriciesEqual(expected, actual) {
+		t.Fatalf("TransformBuilder failed. Matricies were not equal:\n\tExpected: %v,\n\tActual: %v", expected, actual)
+	}
+}
@@ -0,0 +1,136 @@
+package space
+
+import (
+	"fmt"
+	"math"
+)
+
+// Triangle is a flat surface between three corners
+type Triangle struct {
+	A, B, C Cartesian
+}
+
+// NewTriangle creates a new Triangle
+func NewTriangle(a, b, c Cartesian) Triangle {
+	return Triangle{
+		A: a,
+		B: b,
+		C: c,
+	}
+}
+
+// Bounds returns the smallest AABB which contains t
+func (t Triangle) Bounds() AABB {
+	return AABB{
+		Min: Cartesian{
+			X: math.Min(t.A.X, math.Min(t.B.X, t.C.X)),
+			Y: math.Min(t.A.Y, math.Min(t.B.Y, t.C.Y)),
+			Z: math.Min(t.A.Z, math.Min(t.B.Z, t.C.Z)),
+		},
+		Max: Cartesian{
+			X: math.Max(t.A.X, math.Max(t.B.X, t.C.X)),
+			Y: math.Max(t.A.Y, math.Max(t.B.Y, t.C.Y)),
+			Z: math.Max(t.A.Z, math.Max(t.B.Z, t.C.Z)),
+		},
+	}
+}
+
+// Centroid returns the average of the corners of t
+func (t Triangle) Centroid() Cartesian {
+	return Cartesian{
+		X: (t.A.X + t.B.X + t.C.X) / 3,
+		Y: (t.A.Y + t.B.Y + t.C.Y) / 3,
+		Z: (t.A.Z + t.B.Z + t.C.Z) / 3,
+	}
+}
+
+// Normal returns the normal (of length one) of t
+// The normal faces the side from which A, B and C are counter-clockwise.
+// If t has no area, the normal has no length.
+func (t Triangle) Normal() Cartesian {
+	n := t.B.Subtract(t.A).Cross(t.C.Subtract(t.A)).Cartesian()
+	length := n.Length()
+	if length == 0 {
+		return Cartesian{}
+	}
+	return Cartesian{n.X / length, n.Y / length, n.Z / length}
+}
+
+// Area returns the area of t
+func (t Triangle) Area() float64 {
+	return t.B.Subtract(t.A).Cross(t.C.Subtract(t.A)).Length() / 2
+}
+
+// Transform returns t with each corner transformed by m
+func (t Triangle) Transform(m Matrix) Triangle {
+	return Triangle{
+		A: m.Apply(t.A),
+		B: m.Apply(t.B),
+		C: m.Apply(t.C),
+	}
+}
+
+// IntersectRay finds where r meets t
+// The normal of the Hit faces the origin of r.
+func (t Triangle) IntersectRay(r Ray) (Hit, bool) {
+	return r.IntersectTriangle(t.A, t.B, t.C)
+}
+
+// ClosestPoint returns the point on t which is closest to v
+func (t Triangle) ClosestPoint(v Vector) Cartesian {
+	// Ericson, Real-Time Collision Detection, 5.1.5
+	p := v.Cartesian()
+	ab := t.B.Subtract(t.A)
+	ac := t.C.Subtract(t.A)
+
+	// Closest to A
+	ap := p.Subtract(t.A)
+	d1 := ab.Dot(ap)
+	d2 := ac.Dot(ap)
+	if d1 <= 0 && d2 <= 0 {
+		return t.A
+	}
+
+	// Closest to B
+	bp := p.Subtract(t.B)
+	d3 := ab.Dot(bp)
+	d4 := ac.Dot(bp)
+	if d3 >= 0 && d4 <= d3 {
+		return t.B
+	}
+
+	// Closest to the edge AB
+	vc := d1*d4 - d3*d2
+	if vc <= 0 && d1 >= 0 && d3 <= 0 {
+		return t.A.Translate(ab.Scale(d1 / (d1 - d3))).Cartesian()
+	}
+
+	// Closest to C
+	cp := p.Subtract(t.C)
+	d5 := ab.Dot(cp)
+	d6 := ac.Dot(cp)
+	if d6 >= 0 && d5 <= d6 {
+		return t.C
+	}
+
+	// Closest to the edge AC
+	vb := d5*d2 - d1*d6
+	if vb <= 0 && d2 >= 0 && d6 <= 0 {
+		return t.A.Translate(ac.Scale(d2 / (d2 - d6))).Cartesian()
+	}
+
+	// Closest to the edge BC
+	va := d3*d6 - d5*d4
+	if va <= 0 && (d4-d3) >= 0 && (d5-d6) >= 0 {
+		bc := t.C.Subtract(t.B)
+		return t.B.Translate(bc.Scale((d4 - d3) / ((d4 - d3) + (d5 - d6)))).Cartesian()
+	}
+
+	// Closest to the face
+	denom := 1 / (va + vb + vc)
+	return t.A.Translate(ab.Scale(vb * denom)).Translate(ac.Scale(vc * denom)).Cartesian()
+}
+
+func (t Triangle) String() string {
+	return fmt.Sprintf("{A:%v, B:%v, C:%v}", t.A, t.B, t.C)
+}
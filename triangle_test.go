@@ -0,0 +1,157 @@
+package space
+
+import (
+	"math"
+	"testing"
+)
+
+var testTriangle = NewTriangle(Cartesian{0, 0, 0}, Cartesian{2, 0, 0}, Cartesian{0, 2, 0})
+
+func TestTriangleBounds(t *testing.T) {
+	cases := []AABBTest{
+		{
+			Operation: func(AABB) AABB {
+				return testTriangle.Bounds()
+			},
+			Expected: NewAABB(Cartesian{0, 0, 0}, Cartesian{2, 2, 0}),
+		},
+		{
+			Operation: func(AABB) AABB {
+				return NewTriangle(Cartesian{1, -2, 3}, Cartesian{-1, 5, 0}, Cartesian{4, 0, -2}).Bounds()
+			},
+			Expected: NewAABB(Cartesian{-1, -2, -2}, Cartesian{4, 5, 3}),
+		},
+	}
+	RunAABBTests(t, cases)
+}
+
+func TestTriangleProperties(t *testing.T) {
+	cases := []struct {
+		Triangle Triangle
+		Centroid Cartesian
+		Normal   Cartesian
+		Area     float64
+	}{
+		{
+			Triangle: testTriangle,
+			Centroid: Cartesian{2.0 / 3, 2.0 / 3, 0},
+			Normal:   Cartesian{0, 0, 1},
+			Area:     2,
+		},
+		{
+			// Clockwise corners face the other way
+			Triangle: NewTriangle(Cartesian{0, 0, 3}, Cartesian{0, 3, 3}, Cartesian{3, 0, 3}),
+			Centroid: Cartesian{1, 1, 3},
+			Normal:   Cartesian{0, 0, -1},
+			Area:     4.5,
+		},
+		{
+			Triangle: NewTriangle(Cartesian{1, 0, 0}, Cartesian{0, 1, 0}, Cartesian{0, 0, 1}),
+			Centroid: Cartesian{1.0 / 3, 1.0 / 3, 1.0 / 3},
+			Normal:   OctantXYZ.Cartesian,
+			Area:     math.Sqrt(3) / 2,
+		},
+		{
+			// A small triangle still has a normal of length one
+			Triangle: NewTriangle(Cartesian{0, 0, 0}, Cartesian{1e-4, 0, 0}, Cartesian{0, 1e-4, 0}),
+			Centroid: Cartesian{1e-4 / 3, 1e-4 / 3, 0},
+			Normal:   Cartesian{0, 0, 1},
+			Area:     5e-9,
+		},
+		{
+			// No area
+			Triangle: NewTriangle(Cartesian{0, 0, 0}, Cartesian{1, 1, 1}, Cartesian{2, 2, 2}),
+			Centroid: Cartesian{1, 1, 1},
+			Normal:   Cartesian{},
+			Area:     0,
+		},
+	}
+	for i, c := range cases {
+		if actual := c.Triangle.Centroid(); !CartesiansEqual(c.Centroid, actual) {
+			t.Fatalf("Centroid %v failed:\n\tExpected: %v,\n\tActual: %v", i, c.Centroid, actual)
+		}
+		if actual := c.Triangle.Normal(); !CartesiansEqual(c.Normal, actual) {
+			t.Fatalf("Normal %v failed:\n\tExpected: %v,\n\tActual: %v", i, c.Normal, actual)
+		}
+		if actual := c.Triangle.Area(); !near(c.Area, actual) {
+			t.Fatalf("Area %v failed:\n\tExpected: %v,\n\tActual: %v", i, c.Area, actual)
+		}
+	}
+}
+
+func TestTriangleTransform(t *testing.T) {
+	m := NewTransformBuilder().RotateZ(rad(1, 2)).Translate(Cartesian{1, 2, 3}).Matrix()
+	expected := NewTriangle(Cartesian{1, 2, 3}, Cartesian{1, 4, 3}, Cartesian{-1, 2, 3})
+	if actual := testTriangle.Transform(m); !CartesiansEqual(expected.A, actual.A) ||
+		!CartesiansEqual(expected.B, actual.B) || !CartesiansEqual(expected.C, actual.C) {
+		t.Fatalf("Transform failed:\n\tExpected: %v,\n\tActual: %v", expected, actual)
+	}
+}
+
+func TestTriangleIntersectRay(t *testing.T) {
+	cases := []HitTest{
+		{
+			Ray: NewRay(Cartesian{0.5, 0.5, 3}, AxisZN.Spherical),
+			Operation: func(r Ray) (Hit, bool) {
+				return testTriangle.IntersectRay(r)
+			},
+			Expected: Hit{Distance: 3, Point: Cartesian{0.5, 0.5, 0}, Normal: Cartesian{0, 0, 1}},
+			OK:       true,
+		},
+		{
+			Ray: NewRay(Cartesian{1.5, 1.5, 3}, AxisZN.Spherical),
+			Operation: func(r Ray) (Hit, bool) {
+				return testTriangle.IntersectRay(r)
+			},
+			OK: false,
+		},
+	}
+	RunHitTests(t, cases)
+}
+
+func TestTriangleClosestPoint(t *testing.T) {
+	cases := []CartesianTest{
+		{
+			// Above the face
+			Initial:  Cartesian{0.5, 0.5, 4},
+			Expected: Cartesian{0.5, 0.5, 0},
+		},
+		{
+			// On the face
+			Initial:  Cartesian{0.5, 0.5, 0},
+			Expected: Cartesian{0.5, 0.5, 0},
+		},
+		{
+			// Beyond each corner
+			Initial:  Cartesian{-1, -1, 1},
+			Expected: Cartesian{0, 0, 0},
+		},
+		{
+			Initial:  Cartesian{4, -1, -1},
+			Expected: Cartesian{2, 0, 0},
+		},
+		{
+			Initial:  Cartesian{-1, 3, 0},
+			Expected: Cartesian{0, 2, 0},
+		},
+		{
+			// Beyond each edge
+			Initial:  Cartesian{1, -3, 2},
+			Expected: Cartesian{1, 0, 0},
+		},
+		{
+			Initial:  Cartesian{-2, 1.5, 0},
+			Expected: Cartesian{0, 1.5, 0},
+		},
+		{
+			Initial:  Cartesian{2, 2, -5},
+			Expected: Cartesian{1, 1, 0},
+		},
+	}
+	for i := range cases {
+		cases[i].Operation = func(c Cartesian) Cartesian {
+			return testTriangle.ClosestPoint(c)
+		}
+	}
+	RunCartesianTests(t, cases)
+}
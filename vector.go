@@ -5,10 +5,23 @@ package space
 type Vector interface {
 	Cartesian() Cartesian
 	Spherical() Spherical
+	Cylindrical() Cylindrical
 
 	Translate(Vector) Vector
 	Scale(float64) Vector
 	Transform(Matrix) Vector
 
 	Project(Vector) Vector
+
+	Negate() Vector
+	Subtract(Vector) Vector
+	Dot(Vector) float64
+	Cross(Vector) Vector
+
+	Length() float64
+	LengthSquared() float64
+	Normalize() Vector
+
+	DistanceTo(Vector) float64
+	AngleTo(Vector) float64
 }
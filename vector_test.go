@@ -21,6 +21,10 @@ func RunVectorTests(t *testing.T, cases []VectorTest) {
 		if !SphericalsEqual(c.Expected.Spherical, actualSpherical) {
 			t.Fatalf("Test %v failed. Sphericals were not equal:\n\tExpected: %v,\n\tActual: %v", i, c.Expected.Spherical, actualSpherical)
 		}
+		actualCylindrical := v.Cylindrical()
+		if !CylindricalsEqual(c.Expected.Cylindrical, actualCylindrical) {
+			t.Fatalf("Test %v failed. Cylindricals were not equal:\n\tExpected: %v,\n\tActual: %v", i, c.Expected.Cylindrical, actualCylindrical)
+		}
 	}
 }
 
@@ -545,3 +549,384 @@ func TestVectorProject(t *testing.T) {
 	}
 	RunVectorTests(t, cases)
 }
+
+type FloatTest struct {
+	Initial   Vector
+	Operation func(Vector) float64
+	Expected  float64
+}
+
+func RunFloatTests(t *testing.T, cases []FloatTest) {
+	for i, c := range cases {
+		actual := c.Operation(c.Initial)
+		if !near(c.Expected, actual) {
+			t.Fatalf("Test %v failed. Floats were not equal:\n\tExpected: %v,\n\tActual: %v", i, c.Expected, actual)
+		}
+	}
+}
+
+func TestNegate(t *testing.T) {
+	pairs := []struct {
+		Initial  vectorEquivalency
+		Expected vectorEquivalency
+	}{
+		{Origin, Origin},
+		{AxisX, AxisXN},
+		{AxisYN, AxisY},
+		{AxisZ, AxisZN},
+		{AxisZN3, AxisZ3},
+		{OctantXYZ, OctantNXNYNZ},
+		{OctantNXYZ3, OctantXNYNZ3},
+		{OctantXNYNZ, OctantNXYZ},
+	}
+	cases := []VectorTest{}
+	for _, p := range pairs {
+		cases = append(cases,
+			VectorTest{
+				Initial: p.Initial.Cartesian,
+				Operation: func(v Vector) Vector {
+					return v.Negate()
+				},
+				Expected: p.Expected,
+			},
+			VectorTest{
+				Initial: p.Initial.Spherical,
+				Operation: func(v Vector) Vector {
+					return v.Negate()
+				},
+				Expected: p.Expected,
+			},
+			VectorTest{
+				Initial: p.Initial.Cylindrical,
+				Operation: func(v Vector) Vector {
+					return v.Negate()
+				},
+				Expected: p.Expected,
+			},
+		)
+	}
+	RunVectorTests(t, cases)
+}
+
+func TestSubtract(t *testing.T) {
+	cases := []VectorTest{
+		{
+			Initial: Cartesian{1, 1, 4},
+			Operation: func(v Vector) Vector {
+				u := Cartesian{1, 1, 1}
+				return v.Subtract(u)
+			},
+			Expected: AxisZ3,
+		},
+		{
+			Initial: AxisX.Spherical,
+			Operation: func(v Vector) Vector {
+				u := AxisX.Cartesian
+				return v.Subtract(u)
+			},
+			Expected: Origin,
+		},
+		{
+			Initial: AxisY.Spherical,
+			Operation: func(v Vector) Vector {
+				u := AxisY.Spherical.Scale(4)
+				return v.Subtract(u)
+			},
+			Expected: AxisYN3,
+		},
+	}
+	RunVectorTests(t, cases)
+}
+
+func TestCross(t *testing.T) {
+	cases := []VectorTest{
+		{
+			Initial: AxisX.Cartesian,
+			Operation: func(v Vector) Vector {
+				return v.Cross(AxisY.Cartesian)
+			},
+			Expected: AxisZ,
+		},
+		{
+			Initial: AxisY.Spherical,
+			Operation: func(v Vector) Vector {
+				return v.Cross(AxisX.Spherical)
+			},
+			Expected: AxisZN,
+		},
+		{
+			Initial: AxisY3.Cartesian,
+			Operation: func(v Vector) Vector {
+				return v.Cross(AxisZ.Spherical)
+			},
+			Expected: AxisX3,
+		},
+		{
+			Initial: AxisZ.Spherical,
+			Operation: func(v Vector) Vector {
+				return v.Cross(AxisX3.Cartesian)
+			},
+			Expected: AxisY3,
+		},
+		{
+			Initial: OctantXYZ3.Spherical,
+			Operation: func(v Vector) Vector {
+				return v.Cross(OctantXYZ.Cartesian)
+			},
+			Expected: Origin,
+		},
+	}
+	RunVectorTests(t, cases)
+}
+
+func TestNormalize(t *testing.T) {
+	cases := []VectorTest{
+		{
+			Initial: Origin.Cartesian,
+			Operation: func(v Vector) Vector {
+				return v.Normalize()
+			},
+			Expected: Origin,
+		},
+		{
+			Initial: Origin.Spherical,
+			Operation: func(v Vector) Vector {
+				return v.Normalize()
+			},
+			Expected: Origin,
+		},
+		{
+			Initial: AxisX3.Cartesian,
+			Operation: func(v Vector) Vector {
+				return v.Normalize()
+			},
+			Expected: AxisX,
+		},
+		{
+			Initial: AxisYN3.Spherical,
+			Operation: func(v Vector) Vector {
+				return v.Normalize()
+			},
+			Expected: AxisYN,
+		},
+		{
+			Initial: OctantNXYNZ3.Cartesian,
+			Operation: func(v Vector) Vector {
+				return v.Normalize()
+			},
+			Expected: OctantNXYNZ,
+		},
+		{
+			Initial: OctantNXYNZ3.Spherical,
+			Operation: func(v Vector) Vector {
+				return v.Normalize()
+			},
+			Expected: OctantNXYNZ,
+		},
+		{
+			Initial: OctantXYZ3.Spherical,
+			Operation: func(v Vector) Vector {
+				return v.Scale(-1).Normalize()
+			},
+			Expected: OctantNXNYNZ,
+		},
+		{
+			Initial: OctantXNYZ3.Cylindrical,
+			Operation: func(v Vector) Vector {
+				return v.Normalize()
+			},
+			Expected: OctantXNYZ,
+		},
+		{
+			Initial: OctantXNYZ3.Cylindrical,
+			Operation: func(v Vector) Vector {
+				return v.Scale(-1).Normalize()
+			},
+			Expected: OctantNXYNZ,
+		},
+		{
+			// Short vectors still have a direction
+			Initial: Cartesian{1e-7, 0, 0},
+			Operation: func(v Vector) Vector {
+				return v.Normalize()
+			},
+			Expected: AxisX,
+		},
+		{
+			Initial: OctantXNYZ.Spherical,
+			Operation: func(v Vector) Vector {
+				return v.Scale(1e-8).Normalize()
+			},
+			Expected: OctantXNYZ,
+		},
+		{
+			Initial: OctantXNYZ.Cylindrical,
+			Operation: func(v Vector) Vector {
+				return v.Scale(1e-8).Normalize()
+			},
+			Expected: OctantXNYZ,
+		},
+	}
+	RunVectorTests(t, cases)
+}
+
+func TestDot(t *testing.T) {
+	cases := []FloatTest{
+		{
+			Initial: AxisX.Cartesian,
+			Operation: func(v Vector) float64 {
+				return v.Dot(AxisY.Cartesian)
+			},
+			Expected: 0,
+		},
+		{
+			Initial: AxisX3.Spherical,
+			Operation: func(v Vector) float64 {
+				return v.Dot(AxisX.Cartesian)
+			},
+			Expected: 3,
+		},
+		{
+			Initial: AxisZ3.Cartesian,
+			Operation: func(v Vector) float64 {
+				return v.Dot(AxisZN3.Spherical)
+			},
+			Expected: -9,
+		},
+		{
+			Initial: Cartesian{2, 3, 5},
+			Operation: func(v Vector) float64 {
+				return v.Dot(Cartesian{7, -11, 13})
+			},
+			Expected: 46,
+		},
+	}
+	RunFloatTests(t, cases)
+}
+
+func TestLength(t *testing.T) {
+	cases := []FloatTest{}
+	for _, p := range AllEquivalencies {
+		expected := p.Spherical.R
+		cases = append(cases,
+			FloatTest{
+				Initial: p.Cartesian,
+				Operation: func(v Vector) float64 {
+					return v.Length()
+				},
+				Expected: expected,
+			},
+			FloatTest{
+				Initial: p.Spherical,
+				Operation: func(v Vector) float64 {
+					return v.Length()
+				},
+				Expected: expected,
+			},
+			FloatTest{
+				Initial: p.Cylindrical,
+				Operation: func(v Vector) float64 {
+					return v.Length()
+				},
+				Expected: expected,
+			},
+			FloatTest{
+				Initial: p.Cylindrical.Scale(-1),
+				Operation: func(v Vector) float64 {
+					return v.Length()
+				},
+				Expected: expected,
+			},
+			FloatTest{
+				Initial: p.Spherical.Scale(-1),
+				Operation: func(v Vector) float64 {
+					return v.Length()
+				},
+				Expected: expected,
+			},
+			FloatTest{
+				Initial: p.Cartesian,
+				Operation: func(v Vector) float64 {
+					return v.LengthSquared()
+				},
+				Expected: expected * expected,
+			},
+			FloatTest{
+				Initial: p.Spherical,
+				Operation: func(v Vector) float64 {
+					return v.LengthSquared()
+				},
+				Expected: expected * expected,
+			},
+		)
+	}
+	RunFloatTests(t, cases)
+}
+
+func TestDistanceTo(t *testing.T) {
+	cases := []FloatTest{
+		{
+			Initial: Origin.Cartesian,
+			Operation: func(v Vector) float64 {
+				return v.DistanceTo(OctantNXYZ3.Spherical)
+			},
+			Expected: 3,
+		},
+		{
+			Initial: AxisX.Spherical,
+			Operation: func(v Vector) float64 {
+				return v.DistanceTo(AxisXN.Cartesian)
+			},
+			Expected: 2,
+		},
+		{
+			Initial: Cartesian{1, 2, 3},
+			Operation: func(v Vector) float64 {
+				return v.DistanceTo(Cartesian{4, 6, 3})
+			},
+			Expected: 5,
+		},
+	}
+	RunFloatTests(t, cases)
+}
+
+func TestAngleTo(t *testing.T) {
+	cases := []FloatTest{
+		{
+			Initial: AxisX.Cartesian,
+			Operation: func(v Vector) float64 {
+				return v.AngleTo(AxisX3.Spherical)
+			},
+			Expected: 0,
+		},
+		{
+			Initial: AxisX.Spherical,
+			Operation: func(v Vector) float64 {
+				return v.AngleTo(AxisY3.Cartesian)
+			},
+			Expected: rad(1, 2),
+		},
+		{
+			Initial: AxisZ3.Cartesian,
+			Operation: func(v Vector) float64 {
+				return v.AngleTo(AxisZN.Cartesian)
+			},
+			Expected: rad(1, 1),
+		},
+		{
+			Initial: OctantXYZ.Spherical,
+			Operation: func(v Vector) float64 {
+				return v.AngleTo(AxisZ.Spherical)
+			},
+			Expected: OctantXYZ.Spherical.P,
+		},
+		{
+			Initial: Origin.Cartesian,
+			Operation: func(v Vector) float64 {
+				return v.AngleTo(AxisZ.Spherical)
+			},
+			Expected: 0,
+		},
+	}
+	RunFloatTests(t, cases)
+}